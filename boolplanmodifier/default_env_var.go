@@ -23,34 +23,304 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// EnvVarOption configures the SetDefaultEnvVar plan modifier.
+type EnvVarOption func(*envVarOptions)
+
+// unsetBehavior is the behavior of SetDefaultEnvVar when the environment
+// variable is not set.
+type unsetBehavior int
+
+const (
+	unsetError unsetBehavior = iota
+	unsetWarning
+	unsetNull
+	unsetUnknown
+	unsetFallback
+)
+
+type envVarOptions struct {
+	parser     boolParser
+	onUnset    unsetBehavior
+	fallback   bool
+	allowEmpty bool
+	validators []validator.Bool
+}
+
+// boolParser parses a string into a boolean using sets of accepted spellings.
+type boolParser struct {
+	truthy          []string
+	falsy           []string
+	caseInsensitive bool
+	trimSpace       bool
+}
+
+var (
+	// strictBoolParser accepts the same values as strconv.ParseBool.
+	strictBoolParser = boolParser{
+		truthy: []string{"1", "t", "T", "TRUE", "true", "True"},
+		falsy:  []string{"0", "f", "F", "FALSE", "false", "False"},
+	}
+
+	// lenientBoolParser accepts the common spellings, regardless of the case.
+	lenientBoolParser = boolParser{
+		truthy:          []string{"1", "t", "true", "y", "yes", "on", "enable", "enabled"},
+		falsy:           []string{"0", "f", "false", "n", "no", "off", "disable", "disabled"},
+		caseInsensitive: true,
+		trimSpace:       true,
+	}
 )
 
+// EnvVarStrictParser parses the environment variable with the values accepted
+// by strconv.ParseBool. This is the default.
+func EnvVarStrictParser() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.parser = strictBoolParser
+	}
+}
+
+// EnvVarLenientParser parses the environment variable with the common
+// spellings (e.g. yes/no, on/off, enabled/disabled), regardless of the case
+// and of the surrounding whitespace.
+func EnvVarLenientParser() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.parser = lenientBoolParser
+	}
+}
+
+// EnvVarCustomParser parses the environment variable with the given truthy
+// and falsy values, regardless of the case and of the surrounding whitespace.
+func EnvVarCustomParser(truthy, falsy []string) EnvVarOption {
+	return func(o *envVarOptions) {
+		o.parser = boolParser{
+			truthy:          truthy,
+			falsy:           falsy,
+			caseInsensitive: true,
+			trimSpace:       true,
+		}
+	}
+}
+
+// EnvVarUnsetError returns an error diagnostic if the environment variable is
+// not set. This is the default.
+func EnvVarUnsetError() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetError
+	}
+}
+
+// EnvVarUnsetWarning returns a warning diagnostic and leaves the value null
+// if the environment variable is not set.
+func EnvVarUnsetWarning() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetWarning
+	}
+}
+
+// EnvVarUnsetNull leaves the value null if the environment variable is not
+// set.
+func EnvVarUnsetNull() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetNull
+	}
+}
+
+// EnvVarUnsetUnknown leaves the value unknown if the environment variable is
+// not set, so the provider can compute it.
+func EnvVarUnsetUnknown() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetUnknown
+	}
+}
+
+// EnvVarUnsetFallback uses the fallback value if the environment variable is
+// not set.
+func EnvVarUnsetFallback(fallback bool) EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetFallback
+		o.fallback = fallback
+	}
+}
+
+// EnvVarAllowEmpty considers an environment variable set to an empty string
+// as set. By default, it is considered as not set.
+func EnvVarAllowEmpty() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.allowEmpty = true
+	}
+}
+
+// EnvVarValidators runs the validators on the value read from the environment
+// variable. Values injected at plan time are not checked by the schema
+// validators of the attribute.
+func EnvVarValidators(validators ...validator.Bool) EnvVarOption {
+	return func(o *envVarOptions) {
+		o.validators = append(o.validators, validators...)
+	}
+}
+
+func newEnvVarOptions(opts []EnvVarOption) *envVarOptions {
+	o := &envVarOptions{
+		parser: strictBoolParser,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// parse returns the boolean value of s and true if s is an accepted spelling.
+func (p boolParser) parse(s string) (value, ok bool) {
+	if p.trimSpace {
+		s = strings.TrimSpace(s)
+	}
+
+	match := func(values []string) bool {
+		for _, v := range values {
+			if v == s || (p.caseInsensitive && strings.EqualFold(v, s)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case match(p.truthy):
+		return true, true
+	case match(p.falsy):
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// description returns the accepted spellings, formatted with format (e.g.
+// "%s" or "`%s`").
+func (p boolParser) description(format string) string {
+	quote := func(values []string) string {
+		q := make([]string, len(values))
+		for i, v := range values {
+			q[i] = fmt.Sprintf(format, v)
+		}
+		return strings.Join(q, ", ")
+	}
+
+	return fmt.Sprintf("true: %s; false: %s", quote(p.truthy), quote(p.falsy))
+}
+
+// lookup returns the value of the environment variable and whether it is
+// considered as set.
+func (o *envVarOptions) lookup(envVar string) (string, bool) {
+	return o.isSet(os.LookupEnv(envVar))
+}
+
+// isSet returns the value and whether it is considered as set.
+func (o *envVarOptions) isSet(v string, ok bool) (string, bool) {
+	if !ok || (v == "" && !o.allowEmpty) {
+		return "", false
+	}
+
+	return v, true
+}
+
+// unset applies the behavior for an environment variable not set.
+func (o *envVarOptions) unset(envVar string, resp *DefaultFuncResponse) {
+	switch o.onUnset {
+	case unsetWarning:
+		resp.Diagnostics.AddWarning("Environment variable not set", fmt.Sprintf("The environment variable %s is not set", envVar))
+		resp.Null = true
+	case unsetNull:
+		resp.Null = true
+	case unsetUnknown:
+		resp.Unknown = true
+	case unsetFallback:
+		resp.Value = o.fallback
+	case unsetError:
+		resp.Diagnostics.AddError("Environment variable not set", fmt.Sprintf("The environment variable %s is not set", envVar))
+	}
+}
+
+// validate runs the validators on the value read from the environment
+// variable. The diagnostics of the validators are returned with the name of
+// the environment variable.
+func (o *envVarOptions) validate(ctx context.Context, req planmodifier.BoolRequest, envVar string, resp *DefaultFuncResponse) {
+	for _, v := range o.validators {
+		vResp := &validator.BoolResponse{}
+		v.ValidateBool(ctx, validator.BoolRequest{
+			Path:           req.Path,
+			PathExpression: req.PathExpression,
+			Config:         req.Config,
+			ConfigValue:    types.BoolValue(resp.Value),
+		}, vResp)
+
+		for _, d := range vResp.Diagnostics {
+			detail := fmt.Sprintf("The value of the environment variable %s is invalid: %s", envVar, d.Detail())
+			switch d.Severity() {
+			case diag.SeverityError:
+				resp.Diagnostics.AddAttributeError(req.Path, d.Summary(), detail)
+			case diag.SeverityWarning:
+				resp.Diagnostics.AddAttributeWarning(req.Path, d.Summary(), detail)
+			}
+		}
+	}
+}
+
+// setValue parses the value of the environment variable and sets it in the
+// response.
+func (o *envVarOptions) setValue(envVar, v string, resp *DefaultFuncResponse) {
+	// Parse string to bool
+	b, ok := o.parser.parse(v)
+	if !ok {
+		resp.Diagnostics.AddError("Environment variable set but is not Boolean", fmt.Sprintf("The environment variable %s is set but is not a Boolean (accepted values: %s)", envVar, o.parser.description("%q")))
+		return
+	}
+	resp.Value = b
+}
+
 // SetDefaultEnvVar returns a plan modifier that conditionally requires
 // resource replacement if:
 //
 //   - The resource is planned for update.
 //   - The plan and state values are not equal.
 //   - The plan or state values are not null or known
-func SetDefaultEnvVar(envVar string) planmodifier.Bool {
+//
+// The environment variable is parsed with the values accepted by
+// strconv.ParseBool, unless another parser is given (see EnvVarLenientParser
+// and EnvVarCustomParser). An error is returned if the environment variable is
+// not set, unless another behavior is given (see EnvVarUnsetWarning,
+// EnvVarUnsetNull, EnvVarUnsetUnknown and EnvVarUnsetFallback).
+func SetDefaultEnvVar(envVar string, opts ...EnvVarOption) planmodifier.Bool {
+	o := newEnvVarOptions(opts)
+
 	return setDefaultFunc(
-		func(_ context.Context, _ planmodifier.BoolRequest, resp *DefaultFuncResponse) {
-			v := os.Getenv(envVar)
-			if v != "" {
-				// Parse string to bool
-				b, err := strconv.ParseBool(v)
-				if err != nil {
-					resp.Diagnostics.AddError("Environment variable set but is not Boolean", fmt.Sprintf("The environment variable %s is set but is not a Boolean", envVar))
-					return
-				}
-				resp.Value = b
-			} else {
-				resp.Diagnostics.AddError("Environment variable not set", fmt.Sprintf("The environment variable %s is not set", envVar))
-			}
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *DefaultFuncResponse) {
+			v, ok := o.lookup(envVar)
+			o.resolve(ctx, req, envVar, v, ok, resp)
 		},
-		"Set default value from environment variable",
-		"Set default value from environment variable",
+		fmt.Sprintf("Set default value from environment variable (accepted values: %s)", o.parser.description("%s")),
+		fmt.Sprintf("Set default value from environment variable (accepted values: %s)", o.parser.description("`%s`")),
 	)
 }
+
+// resolve parses the value of the variable and sets it in the response, or
+// applies the behavior for a variable not set.
+func (o *envVarOptions) resolve(ctx context.Context, req planmodifier.BoolRequest, name, v string, ok bool, resp *DefaultFuncResponse) {
+	if !ok {
+		o.unset(name, resp)
+		return
+	}
+
+	o.setValue(name, v, resp)
+	if !resp.Diagnostics.HasError() {
+		o.validate(ctx, req, name, resp)
+	}
+}
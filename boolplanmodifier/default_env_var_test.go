@@ -21,13 +21,16 @@ package boolplanmodifier_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/boolplanmodifier"
 )
@@ -117,3 +120,270 @@ func TestDefaultEnvVarModifierPlanModifyBool(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultEnvVarParserModifierPlanModifyBool(t *testing.T) {
+	const envVarName = "TEST_PARSER_VAR"
+
+	testCases := map[string]struct {
+		envValue    string
+		opts        []boolplanmodifier.EnvVarOption
+		expected    types.Bool
+		expectError bool
+	}{
+		"strict true": {
+			envValue: "TRUE",
+			expected: types.BoolValue(true),
+		},
+		"strict rejects yes": {
+			envValue:    "yes",
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"strict rejects whitespace": {
+			envValue:    " true ",
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"explicit strict": {
+			envValue: "0",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarStrictParser()},
+			expected: types.BoolValue(false),
+		},
+		"lenient yes": {
+			envValue: "Yes",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarLenientParser()},
+			expected: types.BoolValue(true),
+		},
+		"lenient on": {
+			envValue: "ON",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarLenientParser()},
+			expected: types.BoolValue(true),
+		},
+		"lenient disabled": {
+			envValue: " disabled ",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarLenientParser()},
+			expected: types.BoolValue(false),
+		},
+		"lenient rejects unknown": {
+			envValue:    "maybe",
+			opts:        []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarLenientParser()},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"custom truthy": {
+			envValue: "oui",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarCustomParser([]string{"oui"}, []string{"non"})},
+			expected: types.BoolValue(true),
+		},
+		"custom falsy": {
+			envValue: "NON",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarCustomParser([]string{"oui"}, []string{"non"})},
+			expected: types.BoolValue(false),
+		},
+		"custom whitespace": {
+			envValue: " oui\n",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarCustomParser([]string{"oui"}, []string{"non"})},
+			expected: types.BoolValue(true),
+		},
+		"custom rejects true": {
+			envValue:    "true",
+			opts:        []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarCustomParser([]string{"oui"}, []string{"non"})},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// set environnement variable
+			t.Setenv(envVarName, testCase.envValue)
+
+			request := planmodifier.BoolRequest{
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			boolplanmodifier.SetDefaultEnvVar(envVarName, testCase.opts...).PlanModifyBool(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDefaultEnvVarDescription(t *testing.T) {
+	m := boolplanmodifier.SetDefaultEnvVar("TEST_VAR", boolplanmodifier.EnvVarCustomParser([]string{"oui"}, []string{"non"}))
+
+	if diff := cmp.Diff("Set default value from environment variable (accepted values: true: oui; false: non)", m.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if diff := cmp.Diff("Set default value from environment variable (accepted values: true: `oui`; false: `non`)", m.MarkdownDescription(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDefaultEnvVarUnsetModifierPlanModifyBool(t *testing.T) {
+	const (
+		envVarName   = "TEST_VAR_UNSET"
+		emptyVarName = "TEST_VAR_EMPTY"
+	)
+
+	t.Setenv(emptyVarName, "")
+
+	testCases := map[string]struct {
+		envVar        string
+		opts          []boolplanmodifier.EnvVarOption
+		expected      types.Bool
+		expectError   bool
+		expectWarning bool
+	}{
+		"unset-error": {
+			envVar:      envVarName,
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"unset-warning": {
+			envVar:        envVarName,
+			opts:          []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetWarning()},
+			expected:      types.BoolNull(),
+			expectWarning: true,
+		},
+		"unset-null": {
+			envVar:   envVarName,
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetNull()},
+			expected: types.BoolNull(),
+		},
+		"unset-unknown": {
+			envVar:   envVarName,
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetUnknown()},
+			expected: types.BoolUnknown(),
+		},
+		"unset-fallback": {
+			envVar:   envVarName,
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetFallback(true)},
+			expected: types.BoolValue(true),
+		},
+		"empty-is-unset": {
+			envVar:   emptyVarName,
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetFallback(true)},
+			expected: types.BoolValue(true),
+		},
+		"empty-allowed": {
+			envVar:      emptyVarName,
+			opts:        []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarAllowEmpty(), boolplanmodifier.EnvVarUnsetFallback(true)},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.BoolRequest{
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			boolplanmodifier.SetDefaultEnvVar(testCase.envVar, testCase.opts...).PlanModifyBool(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != testCase.expectWarning {
+				t.Errorf("expected warning: %v, got: %v", testCase.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// boolValidator is a validator.Bool that rejects the values for which
+// invalid returns true.
+type boolValidator struct {
+	invalid func(bool) bool
+}
+
+func (v boolValidator) Description(_ context.Context) string {
+	return "value must be false"
+}
+
+func (v boolValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v boolValidator) ValidateBool(_ context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	if v.invalid(req.ConfigValue.ValueBool()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid value", "value must be false")
+	}
+}
+
+func TestDefaultEnvVarValidatorsModifierPlanModifyBool(t *testing.T) {
+	const envVarName = "TEST_VAR_VALIDATED"
+
+	v := boolValidator{invalid: func(v bool) bool { return v }}
+
+	testCases := map[string]struct {
+		envValue    string
+		expected    types.Bool
+		expectError bool
+	}{
+		"valid": {
+			envValue: "false",
+			expected: types.BoolValue(false),
+		},
+		"invalid": {
+			envValue:    "true",
+			expected:    types.BoolValue(true),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envVarName, testCase.envValue)
+
+			request := planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			boolplanmodifier.SetDefaultEnvVar(envVarName, boolplanmodifier.EnvVarValidators(v)).PlanModifyBool(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			for _, d := range resp.Diagnostics.Errors() {
+				if !strings.Contains(d.Detail(), envVarName) {
+					t.Errorf("expected the environment variable name in the diagnostic, got: %s", d.Detail())
+				}
+			}
+		})
+	}
+}
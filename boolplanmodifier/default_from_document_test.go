@@ -0,0 +1,112 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package boolplanmodifier provides a plan modifier for boolean values.
+package boolplanmodifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/boolplanmodifier"
+)
+
+func TestDefaultFromDocumentModifierPlanModifyBool(t *testing.T) {
+	dir := t.TempDir()
+
+	jsonFile := filepath.Join(dir, "settings.json")
+	if err := os.WriteFile(jsonFile, []byte(`{"defaults": {"enabled": true, "flag": "true"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	yamlFile := filepath.Join(dir, "settings.yaml")
+	if err := os.WriteFile(yamlFile, []byte(`defaults:
+  enabled: true
+  list:
+    - true
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		modifier      planmodifier.Bool
+		expected      types.Bool
+		expectedError string
+	}{
+		"json": {
+			modifier: boolplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/enabled"),
+			expected: types.BoolValue(true),
+		},
+		"yaml": {
+			modifier: boolplanmodifier.SetDefaultFromYAMLFile(yamlFile, "/defaults/enabled"),
+			expected: types.BoolValue(true),
+		},
+		"type mismatch": {
+			modifier:      boolplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/flag"),
+			expected:      types.BoolValue(false),
+			expectedError: "is a string, expected a boolean",
+		},
+		"array": {
+			modifier:      boolplanmodifier.SetDefaultFromYAMLFile(yamlFile, "/defaults/list"),
+			expected:      types.BoolValue(false),
+			expectedError: "is an array, expected a boolean",
+		},
+		"through scalar": {
+			modifier:      boolplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/enabled/value"),
+			expected:      types.BoolValue(false),
+			expectedError: "is a boolean, it has no member \"value\"",
+		},
+		"missing file": {
+			modifier:      boolplanmodifier.SetDefaultFromYAMLFile(filepath.Join(dir, "missing.yaml"), "/defaults/enabled"),
+			expected:      types.BoolValue(false),
+			expectedError: "does not exist",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyBool(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if testCase.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package boolplanmodifier provides a plan modifier for boolean values.
+package boolplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/dotenv"
+)
+
+// SetDefaultFromDotenv returns a plan modifier that sets the default value
+// from the key of the dotenv file (e.g. ".env"), for the variables that are
+// not exported to the Terraform process. The file is parsed once per process.
+// A leading ~ in the path is expanded to the home directory of the user.
+//
+// The value is handled like SetDefaultEnvVar does with the given options: it
+// is parsed with the values accepted by strconv.ParseBool, unless another
+// parser is given. A file that does not exist is handled like a key not set.
+func SetDefaultFromDotenv(file, key string, opts ...EnvVarOption) planmodifier.Bool {
+	o := newEnvVarOptions(opts)
+	name := fmt.Sprintf("%s (from %s)", key, file)
+
+	return setDefaultFunc(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *DefaultFuncResponse) {
+			values, err := dotenv.Load(file)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Unable to read dotenv file", fmt.Sprintf("The dotenv file %s cannot be read: %s", file, err))
+				return
+			}
+
+			v, ok := values[key]
+			v, ok = o.isSet(v, ok)
+			o.resolve(ctx, req, name, v, ok, resp)
+		},
+		fmt.Sprintf("Set default value from the key %s of the dotenv file %q (accepted values: %s)", key, file, o.parser.description("%s")),
+		fmt.Sprintf("Set default value from the key `%s` of the dotenv file `%s` (accepted values: %s)", key, file, o.parser.description("`%s`")),
+	)
+}
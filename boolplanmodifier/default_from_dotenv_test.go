@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package boolplanmodifier provides a plan modifier for boolean values.
+package boolplanmodifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/boolplanmodifier"
+)
+
+func TestDefaultFromDotenvModifierPlanModifyBool(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".env")
+	content := "# settings\nexport CAV_GOOD=\"true\" # comment\nCAV_BAD='maybe'\n"
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		key         string
+		opts        []boolplanmodifier.EnvVarOption
+		expected    types.Bool
+		expectError bool
+	}{
+		"valid": {
+			key:      "CAV_GOOD",
+			expected: types.BoolValue(true),
+		},
+		"invalid": {
+			key:         "CAV_BAD",
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"key not set": {
+			key:         "CAV_MISSING",
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"key not set with fallback": {
+			key:      "CAV_MISSING",
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetFallback(true)},
+			expected: types.BoolValue(true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			boolplanmodifier.SetDefaultFromDotenv(file, testCase.key, testCase.opts...).PlanModifyBool(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDefaultFromDotenvDescription(t *testing.T) {
+	m := boolplanmodifier.SetDefaultFromDotenv(".env", "ENABLED", boolplanmodifier.EnvVarCustomParser([]string{"oui"}, []string{"non"}))
+
+	if diff := cmp.Diff(`Set default value from the key ENABLED of the dotenv file ".env" (accepted values: true: oui; false: non)`, m.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if diff := cmp.Diff("Set default value from the key `ENABLED` of the dotenv file `.env` (accepted values: true: `oui`; false: `non`)", m.MarkdownDescription(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package boolplanmodifier provides a plan modifier for boolean values.
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/boolplanmodifier"
+)
+
+func TestDefaultFromRefModifierPlanModifyBool(t *testing.T) {
+	t.Setenv("TEST_REF_VAR", "true")
+
+	testCases := map[string]struct {
+		refs        []string
+		expected    types.Bool
+		expectError bool
+	}{
+		"first reference": {
+			refs:     []string{"env://TEST_REF_VAR", "literal://false"},
+			expected: types.BoolValue(true),
+		},
+		"fallback reference": {
+			refs:     []string{"env://TEST_REF_UNSET", "literal://false"},
+			expected: types.BoolValue(false),
+		},
+		"no value": {
+			refs:        []string{"env://TEST_REF_UNSET"},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"unknown scheme": {
+			refs:        []string{"unknown://TEST_REF_VAR"},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"invalid value": {
+			refs:        []string{"literal://yes"},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			boolplanmodifier.SetDefaultFromRef(testCase.refs...).PlanModifyBool(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
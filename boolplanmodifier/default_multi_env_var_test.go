@@ -0,0 +1,118 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package boolplanmodifier provides a plan modifier for boolean values.
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/boolplanmodifier"
+)
+
+func TestDefaultMultiEnvVarModifierPlanModifyBool(t *testing.T) {
+	const (
+		newEnvVarName    = "TEST_MULTI_NEW_VAR"
+		legacyEnvVarName = "TEST_MULTI_LEGACY_VAR"
+	)
+
+	testCases := map[string]struct {
+		env         map[string]string
+		opts        []boolplanmodifier.EnvVarOption
+		expected    types.Bool
+		expectError bool
+	}{
+		"first variable": {
+			env:      map[string]string{newEnvVarName: "false", legacyEnvVarName: "true"},
+			expected: types.BoolValue(false),
+		},
+		"legacy variable": {
+			env:      map[string]string{newEnvVarName: "", legacyEnvVarName: "true"},
+			expected: types.BoolValue(true),
+		},
+		"fallback": {
+			env:      map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			expected: types.BoolValue(true),
+		},
+		"lenient parser": {
+			env:      map[string]string{newEnvVarName: "", legacyEnvVarName: "off"},
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarLenientParser()},
+			expected: types.BoolValue(false),
+		},
+		"allow empty": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: "true"},
+			opts:        []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarAllowEmpty()},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"unset null": {
+			env:      map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			opts:     []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetNull()},
+			expected: types.BoolNull(),
+		},
+		"unset error": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			opts:        []boolplanmodifier.EnvVarOption{boolplanmodifier.EnvVarUnsetError()},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+		"not a boolean": {
+			env:         map[string]string{newEnvVarName: "maybe", legacyEnvVarName: "true"},
+			expected:    types.BoolValue(false),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// set environnement variables
+			for k, v := range testCase.env {
+				t.Setenv(k, v)
+			}
+
+			request := planmodifier.BoolRequest{
+				StateValue:  types.BoolNull(),
+				PlanValue:   types.BoolUnknown(),
+				ConfigValue: types.BoolNull(),
+			}
+			resp := &planmodifier.BoolResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			boolplanmodifier.SetDefaultMultiEnvVar([]string{newEnvVarName, legacyEnvVarName}, true, testCase.opts...).PlanModifyBool(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDefaultMultiEnvVarDescription(t *testing.T) {
+	m := boolplanmodifier.SetDefaultMultiEnvVar([]string{"TEST_VAR"}, false, boolplanmodifier.EnvVarCustomParser([]string{"oui"}, []string{"non"}))
+
+	if diff := cmp.Diff("Set default value from environment variables (accepted values: true: oui; false: non)", m.Description(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if diff := cmp.Diff("Set default value from environment variables (accepted values: true: `oui`; false: `non`)", m.MarkdownDescription(context.Background())); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
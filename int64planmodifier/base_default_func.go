@@ -40,6 +40,12 @@ type DefaultFuncResponse struct {
 
 	// Value is the value to use by default if the attribute is not configured.
 	Value int64
+
+	// Null sets the plan value to null instead of Value.
+	Null bool
+
+	// Unknown sets the plan value to unknown instead of Value.
+	Unknown bool
 }
 
 // setDefaultFunc returns a plan modifier that conditionally requires
@@ -98,5 +104,12 @@ func (m defaultFuncPlanModifier) PlanModifyInt64(ctx context.Context, req planmo
 	m.f(ctx, req, funcResp)
 
 	resp.Diagnostics.Append(funcResp.Diagnostics...)
-	resp.PlanValue = basetypes.NewInt64Value(funcResp.Value)
+	switch {
+	case funcResp.Null:
+		resp.PlanValue = basetypes.NewInt64Null()
+	case funcResp.Unknown:
+		resp.PlanValue = basetypes.NewInt64Unknown()
+	default:
+		resp.PlanValue = basetypes.NewInt64Value(funcResp.Value)
+	}
 }
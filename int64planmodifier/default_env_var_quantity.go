@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package int64planmodifier provides a plan modifier for int64 values.
+package int64planmodifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/quantity"
+)
+
+// SetDefaultEnvVarQuantity returns a plan modifier that sets the default
+// value from the environment variable, which may be written with a unit
+// (e.g. "20GiB" or "500m"), in hexadecimal ("0x400") or with underscores
+// ("10_240"). The value is converted to the unit of the attribute (e.g. "MiB"
+// or "m" for millicores). Values without unit are read in the attribute unit.
+//
+// Leading zeros do not make an octal number ("010GB" is 10GB). A unit after a
+// hexadecimal, octal or binary number must be separated by a space
+// ("0x10 GiB"), "0x10EB" is the hexadecimal number 0x10EB.
+//
+// The default is set if:
+//
+//   - The resource is planned for update.
+//   - The plan and state values are not equal.
+//   - The plan or state values are not null or known
+//
+// The options are the ones of SetDefaultEnvVar, the fallback of
+// EnvVarUnsetFallback is expressed in the attribute unit and the validators of
+// EnvVarValidators check the converted value. An error is returned
+// if the environment variable is not set, unless another behavior is given.
+func SetDefaultEnvVarQuantity(envVar, unit string, opts ...EnvVarOption) planmodifier.Int64 {
+	o := newEnvVarOptions(opts)
+	description := fmt.Sprintf("Set default value from environment variable, converted to %s", unit)
+
+	return setDefaultFunc(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *DefaultFuncResponse) {
+			units, multiplier, ok := quantityUnits(unit)
+			if !ok {
+				resp.Diagnostics.AddError("Invalid unit", fmt.Sprintf("The unit %q is not a known size or CPU unit", unit))
+				return
+			}
+
+			v, ok := o.lookup(envVar)
+			if !ok {
+				o.unset(envVar, resp)
+				return
+			}
+
+			// A value without unit is expressed in the attribute unit.
+			units[""] = multiplier
+
+			setEnvVarQuantityValue(envVar, v, unit, units, multiplier, resp)
+			if !resp.Diagnostics.HasError() {
+				o.validate(ctx, req, envVar, resp)
+			}
+		},
+		description,
+		description,
+	)
+}
+
+// setEnvVarQuantityValue converts the value of the environment variable to the
+// unit and sets it in the response.
+func setEnvVarQuantityValue(envVar, v, unit string, units map[string]int64, multiplier int64, resp *DefaultFuncResponse) {
+	q, err := quantity.Parse(v, units)
+	switch {
+	case errors.Is(err, quantity.ErrNegative):
+		resp.Diagnostics.AddError("Environment variable set but is negative", fmt.Sprintf("The environment variable %s is set but is negative: %s", envVar, err))
+		return
+	case err != nil:
+		resp.Diagnostics.AddError("Environment variable set but is not a quantity", fmt.Sprintf("The environment variable %s is set but is not a quantity: %s", envVar, err))
+		return
+	}
+
+	q.Quo(q, new(big.Rat).SetInt64(multiplier))
+	if !q.IsInt() {
+		resp.Diagnostics.AddError("Environment variable set but is not a whole number", fmt.Sprintf("The environment variable %s is set but is not a whole number of %s", envVar, unit))
+		return
+	}
+
+	if !q.Num().IsInt64() {
+		resp.Diagnostics.AddError("Environment variable set but overflows Int64", fmt.Sprintf("The environment variable %s is set but overflows a Int64 once converted to %s", envVar, unit))
+		return
+	}
+
+	resp.Value = q.Num().Int64()
+}
+
+// quantityUnits returns a copy of the units the given unit belongs to and its
+// multiplier.
+func quantityUnits(unit string) (map[string]int64, int64, bool) {
+	for _, units := range []map[string]int64{quantity.Bytes, quantity.CPU} {
+		if multiplier, ok := units[unit]; ok && unit != "" {
+			c := make(map[string]int64, len(units))
+			for k, v := range units {
+				c[k] = v
+			}
+			return c, multiplier, true
+		}
+	}
+
+	return nil, 0, false
+}
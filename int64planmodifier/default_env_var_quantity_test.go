@@ -0,0 +1,218 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package int64planmodifier provides a plan modifier for int64 values.
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/int64planmodifier"
+)
+
+func TestDefaultEnvVarQuantityModifierPlanModifyInt64(t *testing.T) {
+	const envVarName = "TEST_QUANTITY_VAR"
+
+	testCases := map[string]struct {
+		envValue    string
+		unit        string
+		opts        []int64planmodifier.EnvVarOption
+		expected    types.Int64
+		expectError bool
+	}{
+		"unit suffix": {
+			envValue: "20GiB",
+			unit:     "MiB",
+			expected: types.Int64Value(20480),
+		},
+		"decimal amount": {
+			envValue: "1.5 GiB",
+			unit:     "MiB",
+			expected: types.Int64Value(1536),
+		},
+		"SI unit": {
+			envValue: "2GB",
+			unit:     "MB",
+			expected: types.Int64Value(2000),
+		},
+		"no unit": {
+			envValue: "1024",
+			unit:     "MiB",
+			expected: types.Int64Value(1024),
+		},
+		"hexadecimal": {
+			envValue: "0x400",
+			unit:     "MiB",
+			expected: types.Int64Value(1024),
+		},
+		"hexadecimal with unit": {
+			envValue: "0x10 GiB",
+			unit:     "MiB",
+			expected: types.Int64Value(16384),
+		},
+		"leading zero": {
+			envValue: "010GB",
+			unit:     "MB",
+			expected: types.Int64Value(10000),
+		},
+		"leading zero with 8": {
+			envValue: "08GB",
+			unit:     "MB",
+			expected: types.Int64Value(8000),
+		},
+		"leading zero binary unit": {
+			envValue: "020GiB",
+			unit:     "MiB",
+			expected: types.Int64Value(20480),
+		},
+		"leading zero no unit": {
+			envValue: "010",
+			unit:     "MiB",
+			expected: types.Int64Value(10),
+		},
+		"hexadecimal digits are not a unit": {
+			envValue: "0x1B",
+			unit:     "B",
+			expected: types.Int64Value(27),
+		},
+		"hexadecimal with unit after a space": {
+			envValue: "0x1 EB",
+			unit:     "GB",
+			expected: types.Int64Value(1000000000),
+		},
+		"octal with unit": {
+			envValue: "0o10 KiB",
+			unit:     "B",
+			expected: types.Int64Value(8192),
+		},
+		"octal with unit without space": {
+			envValue:    "0o10KiB",
+			unit:        "B",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"binary with unit without space": {
+			envValue:    "0b10MB",
+			unit:        "B",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"underscores": {
+			envValue: "10_240MiB",
+			unit:     "MiB",
+			expected: types.Int64Value(10240),
+		},
+		"millicores": {
+			envValue: "2000m",
+			unit:     "m",
+			expected: types.Int64Value(2000),
+		},
+		"not a whole number": {
+			envValue:    "1.5KiB",
+			unit:        "MiB",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"negative": {
+			envValue:    "-20GiB",
+			unit:        "MiB",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"overflow": {
+			envValue:    "16EiB",
+			unit:        "B",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"invalid": {
+			envValue:    "twenty",
+			unit:        "MiB",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"unknown unit": {
+			envValue:    "20GiB",
+			unit:        "parsec",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"not set": {
+			envValue:    "",
+			unit:        "MiB",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"not set with fallback": {
+			envValue: "",
+			unit:     "MiB",
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetFallback(512)},
+			expected: types.Int64Value(512),
+		},
+		"not set null": {
+			envValue: "",
+			unit:     "MiB",
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetNull()},
+			expected: types.Int64Null(),
+		},
+		"validators": {
+			envValue:    "20GiB",
+			unit:        "MiB",
+			opts:        []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarValidators(int64Validator{invalid: func(v int64) bool { return v > 10240 }})},
+			expected:    types.Int64Value(20480),
+			expectError: true,
+		},
+		"validators valid": {
+			envValue: "10GiB",
+			unit:     "MiB",
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarValidators(int64Validator{invalid: func(v int64) bool { return v > 10240 }})},
+			expected: types.Int64Value(10240),
+		},
+		"empty allowed": {
+			envValue:    "",
+			unit:        "MiB",
+			opts:        []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarAllowEmpty(), int64planmodifier.EnvVarUnsetFallback(512)},
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// set environnement variable
+			t.Setenv(envVarName, testCase.envValue)
+
+			request := planmodifier.Int64Request{
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: request.PlanValue,
+			}
+
+			int64planmodifier.SetDefaultEnvVarQuantity(envVarName, testCase.unit, testCase.opts...).PlanModifyInt64(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -21,13 +21,16 @@ package int64planmodifier_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/int64planmodifier"
 )
@@ -117,3 +120,159 @@ func TestDefaultEnvVarModifierPlanModifyInt64(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultEnvVarUnsetModifierPlanModifyInt64(t *testing.T) {
+	const (
+		envVarName   = "TEST_VAR_UNSET"
+		emptyVarName = "TEST_VAR_EMPTY"
+	)
+
+	t.Setenv(emptyVarName, "")
+
+	testCases := map[string]struct {
+		envVar        string
+		opts          []int64planmodifier.EnvVarOption
+		expected      types.Int64
+		expectError   bool
+		expectWarning bool
+	}{
+		"unset-error": {
+			envVar:      envVarName,
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"unset-warning": {
+			envVar:        envVarName,
+			opts:          []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetWarning()},
+			expected:      types.Int64Null(),
+			expectWarning: true,
+		},
+		"unset-null": {
+			envVar:   envVarName,
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetNull()},
+			expected: types.Int64Null(),
+		},
+		"unset-unknown": {
+			envVar:   envVarName,
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetUnknown()},
+			expected: types.Int64Unknown(),
+		},
+		"unset-fallback": {
+			envVar:   envVarName,
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetFallback(42)},
+			expected: types.Int64Value(42),
+		},
+		"empty-is-unset": {
+			envVar:   emptyVarName,
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetFallback(42)},
+			expected: types.Int64Value(42),
+		},
+		"empty-allowed": {
+			envVar:      emptyVarName,
+			opts:        []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarAllowEmpty(), int64planmodifier.EnvVarUnsetFallback(42)},
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.Int64Request{
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: request.PlanValue,
+			}
+
+			int64planmodifier.SetDefaultEnvVar(testCase.envVar, testCase.opts...).PlanModifyInt64(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != testCase.expectWarning {
+				t.Errorf("expected warning: %v, got: %v", testCase.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// int64Validator is a validator.Int64 that rejects the values for which
+// invalid returns true.
+type int64Validator struct {
+	invalid func(int64) bool
+}
+
+func (v int64Validator) Description(_ context.Context) string {
+	return "value must be at least 10"
+}
+
+func (v int64Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v int64Validator) ValidateInt64(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if v.invalid(req.ConfigValue.ValueInt64()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid value", "value must be at least 10")
+	}
+}
+
+func TestDefaultEnvVarValidatorsModifierPlanModifyInt64(t *testing.T) {
+	const envVarName = "TEST_VAR_VALIDATED"
+
+	v := int64Validator{invalid: func(v int64) bool { return v < 10 }}
+
+	testCases := map[string]struct {
+		envValue    string
+		expected    types.Int64
+		expectError bool
+	}{
+		"valid": {
+			envValue: "100",
+			expected: types.Int64Value(100),
+		},
+		"invalid": {
+			envValue:    "5",
+			expected:    types.Int64Value(5),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envVarName, testCase.envValue)
+
+			request := planmodifier.Int64Request{
+				Path:        path.Root("test"),
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: request.PlanValue,
+			}
+
+			int64planmodifier.SetDefaultEnvVar(envVarName, int64planmodifier.EnvVarValidators(v)).PlanModifyInt64(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			for _, d := range resp.Diagnostics.Errors() {
+				if !strings.Contains(d.Detail(), envVarName) {
+					t.Errorf("expected the environment variable name in the diagnostic, got: %s", d.Detail())
+				}
+			}
+		})
+	}
+}
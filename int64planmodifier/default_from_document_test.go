@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package int64planmodifier provides a plan modifier for int64 values.
+package int64planmodifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/int64planmodifier"
+)
+
+func TestDefaultFromDocumentModifierPlanModifyInt64(t *testing.T) {
+	dir := t.TempDir()
+
+	jsonFile := filepath.Join(dir, "settings.json")
+	if err := os.WriteFile(jsonFile, []byte(`{"defaults": {"disk_size": 100, "max": 9223372036854775807, "exponent": 1e3, "region": "fr-paris", "ratio": 1.5, "overflow": 9223372036854775808}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	yamlFile := filepath.Join(dir, "settings.yaml")
+	if err := os.WriteFile(yamlFile, []byte(`defaults:
+  disk_size: 100
+  ratio: 1.5
+  none: null
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		modifier      planmodifier.Int64
+		expected      types.Int64
+		expectedError string
+	}{
+		"json": {
+			modifier: int64planmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/disk_size"),
+			expected: types.Int64Value(100),
+		},
+		"json large": {
+			modifier: int64planmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/max"),
+			expected: types.Int64Value(9223372036854775807),
+		},
+		"json exponent": {
+			modifier: int64planmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/exponent"),
+			expected: types.Int64Value(1000),
+		},
+		"yaml": {
+			modifier: int64planmodifier.SetDefaultFromYAMLFile(yamlFile, "/defaults/disk_size"),
+			expected: types.Int64Value(100),
+		},
+		"type mismatch": {
+			modifier:      int64planmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/region"),
+			expected:      types.Int64Value(0),
+			expectedError: "is a string, expected a number",
+		},
+		"fraction": {
+			modifier:      int64planmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/ratio"),
+			expected:      types.Int64Value(0),
+			expectedError: "is 1.5, not a whole number",
+		},
+		"overflow": {
+			modifier:      int64planmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/overflow"),
+			expected:      types.Int64Value(0),
+			expectedError: "out of the int64 range",
+		},
+		"yaml fraction": {
+			modifier:      int64planmodifier.SetDefaultFromYAMLFile(yamlFile, "/defaults/ratio"),
+			expected:      types.Int64Value(0),
+			expectedError: "is 1.5, not a whole number",
+		},
+		"null": {
+			modifier:      int64planmodifier.SetDefaultFromYAMLFile(yamlFile, "/defaults/none"),
+			expected:      types.Int64Value(0),
+			expectedError: "is null, expected a number",
+		},
+		"not found": {
+			modifier:      int64planmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/cpu"),
+			expected:      types.Int64Value(0),
+			expectedError: "has no member \"cpu\"",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.Int64Request{
+				Path:        path.Root("test"),
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyInt64(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if testCase.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
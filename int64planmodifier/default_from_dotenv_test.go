@@ -0,0 +1,86 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package int64planmodifier provides a plan modifier for int64 values.
+package int64planmodifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/int64planmodifier"
+)
+
+func TestDefaultFromDotenvModifierPlanModifyInt64(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".env")
+	content := "# settings\nexport CAV_GOOD=\"100\" # comment\nCAV_BAD='abc'\n"
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		key         string
+		opts        []int64planmodifier.EnvVarOption
+		expected    types.Int64
+		expectError bool
+	}{
+		"valid": {
+			key:      "CAV_GOOD",
+			expected: types.Int64Value(100),
+		},
+		"invalid": {
+			key:         "CAV_BAD",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"key not set": {
+			key:         "CAV_MISSING",
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"key not set with fallback": {
+			key:      "CAV_MISSING",
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetFallback(42)},
+			expected: types.Int64Value(42),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.Int64Request{
+				Path:        path.Root("test"),
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: request.PlanValue,
+			}
+
+			int64planmodifier.SetDefaultFromDotenv(file, testCase.key, testCase.opts...).PlanModifyInt64(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
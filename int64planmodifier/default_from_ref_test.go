@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package int64planmodifier provides a plan modifier for int64 values.
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/int64planmodifier"
+)
+
+func TestDefaultFromRefModifierPlanModifyInt64(t *testing.T) {
+	t.Setenv("TEST_REF_VAR", "100")
+
+	testCases := map[string]struct {
+		refs        []string
+		expected    types.Int64
+		expectError bool
+	}{
+		"first reference": {
+			refs:     []string{"env://TEST_REF_VAR", "literal://10"},
+			expected: types.Int64Value(100),
+		},
+		"fallback reference": {
+			refs:     []string{"env://TEST_REF_UNSET", "literal://10"},
+			expected: types.Int64Value(10),
+		},
+		"no value": {
+			refs:        []string{"env://TEST_REF_UNSET"},
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"unknown scheme": {
+			refs:        []string{"unknown://TEST_REF_VAR"},
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"invalid value": {
+			refs:        []string{"literal://1.5"},
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.Int64Request{
+				Path:        path.Root("test"),
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: request.PlanValue,
+			}
+
+			int64planmodifier.SetDefaultFromRef(testCase.refs...).PlanModifyInt64(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package int64planmodifier provides a plan modifier for int64 values.
+package int64planmodifier
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SetDefaultMultiEnvVar returns a plan modifier that sets the default value
+// from the first environment variable of names that is set, or to fallback if
+// none of them is set. It is similar to MultiEnvDefaultFunc of the SDKv2 and
+// helps migrating from legacy variable names.
+//
+// The options are the ones of SetDefaultEnvVar: EnvVarAllowEmpty considers
+// the empty variables as set, EnvVarValidators checks the value read and the
+// EnvVarUnset* options replace the fallback when none of the variables is set.
+//
+// The default is set if:
+//
+//   - The resource is planned for update.
+//   - The plan and state values are not equal.
+//   - The plan or state values are not null or known
+func SetDefaultMultiEnvVar(names []string, fallback int64, opts ...EnvVarOption) planmodifier.Int64 {
+	o := newEnvVarOptions(append([]EnvVarOption{EnvVarUnsetFallback(fallback)}, opts...))
+
+	return setDefaultFunc(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *DefaultFuncResponse) {
+			for _, name := range names {
+				if v, ok := o.lookup(name); ok {
+					tflog.Debug(ctx, "Default value set from environment variable", map[string]interface{}{"env_var": name})
+					o.resolve(ctx, req, name, v, ok, resp)
+					return
+				}
+			}
+
+			if o.onUnset == unsetFallback {
+				tflog.Debug(ctx, "No environment variable set, default value set from fallback", map[string]interface{}{"env_vars": names})
+			} else {
+				tflog.Debug(ctx, "No environment variable set", map[string]interface{}{"env_vars": names})
+			}
+			o.unset(strings.Join(names, " or "), resp)
+		},
+		"Set default value from environment variables",
+		"Set default value from environment variables",
+	)
+}
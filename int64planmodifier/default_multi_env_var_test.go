@@ -0,0 +1,101 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package int64planmodifier provides a plan modifier for int64 values.
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/int64planmodifier"
+)
+
+func TestDefaultMultiEnvVarModifierPlanModifyInt64(t *testing.T) {
+	const (
+		newEnvVarName    = "TEST_MULTI_NEW_VAR"
+		legacyEnvVarName = "TEST_MULTI_LEGACY_VAR"
+	)
+
+	testCases := map[string]struct {
+		env         map[string]string
+		opts        []int64planmodifier.EnvVarOption
+		expected    types.Int64
+		expectError bool
+	}{
+		"first variable": {
+			env:      map[string]string{newEnvVarName: "10", legacyEnvVarName: "20"},
+			expected: types.Int64Value(10),
+		},
+		"legacy variable": {
+			env:      map[string]string{newEnvVarName: "", legacyEnvVarName: "20"},
+			expected: types.Int64Value(20),
+		},
+		"fallback": {
+			env:      map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			expected: types.Int64Value(42),
+		},
+		"allow empty": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: "20"},
+			opts:        []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarAllowEmpty()},
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+		"unset null": {
+			env:      map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			opts:     []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarUnsetNull()},
+			expected: types.Int64Null(),
+		},
+		"validators": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: "20"},
+			opts:        []int64planmodifier.EnvVarOption{int64planmodifier.EnvVarValidators(int64Validator{invalid: func(v int64) bool { return v > 10 }})},
+			expected:    types.Int64Value(20),
+			expectError: true,
+		},
+		"not an int64": {
+			env:         map[string]string{newEnvVarName: "ten", legacyEnvVarName: "20"},
+			expected:    types.Int64Value(0),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// set environnement variables
+			for k, v := range testCase.env {
+				t.Setenv(k, v)
+			}
+
+			request := planmodifier.Int64Request{
+				StateValue:  types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				ConfigValue: types.Int64Null(),
+			}
+			resp := &planmodifier.Int64Response{
+				PlanValue: request.PlanValue,
+			}
+
+			int64planmodifier.SetDefaultMultiEnvVar([]string{newEnvVarName, legacyEnvVarName}, 42, testCase.opts...).PlanModifyInt64(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
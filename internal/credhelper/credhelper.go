@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package credhelper gets values from a local executable (a credential
+// helper), with a JSON protocol similar to the git credential helpers.
+//
+// The helper receives a JSON object on its standard input:
+//
+//	{"action": "get", "key": "api_token"}
+//
+// and writes a JSON object on its standard output:
+//
+//	{"value": "..."}
+//
+// The value is omitted (or null) if the helper has no value for the key. The
+// helper reports its errors with {"error": "..."} or a non-zero exit status.
+package credhelper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/fsutil"
+)
+
+// waitDelay is the time given to the helper to close its output once it is
+// killed, e.g. when a process it forked still holds the pipes.
+const waitDelay = time.Second
+
+// ErrNotFound is returned by Get if the helper has no value for the key.
+var ErrNotFound = errors.New("no value for the key")
+
+type request struct {
+	Action string `json:"action"`
+	Key    string `json:"key"`
+}
+
+type response struct {
+	Value *string `json:"value"`
+	Error string  `json:"error"`
+}
+
+type entry struct {
+	mu    sync.Mutex
+	value string
+	ok    bool
+}
+
+var (
+	mu    sync.Mutex
+	cache = map[string]*entry{}
+)
+
+// Get returns the value of the key from the helper. The values are cached per
+// process, the errors are not, so a failing helper is called again. The
+// helper and the processes it forks are stopped when the context is done.
+//
+// The value is never included in the errors.
+func Get(ctx context.Context, command string, args []string, key string) (string, error) {
+	cacheKey, err := json.Marshal([]interface{}{command, args, key})
+	if err != nil {
+		return "", err
+	}
+
+	mu.Lock()
+	e, ok := cache[string(cacheKey)]
+	if !ok {
+		e = &entry{}
+		cache[string(cacheKey)] = e
+	}
+	mu.Unlock()
+
+	// Only one call of the helper at a time for a key.
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.ok {
+		return e.value, nil
+	}
+
+	v, err := run(ctx, command, args, key)
+	if err != nil {
+		return "", err
+	}
+
+	e.value, e.ok = v, true
+
+	return v, nil
+}
+
+func run(ctx context.Context, command string, args []string, key string) (string, error) {
+	name, err := fsutil.ExpandHome(command)
+	if err != nil {
+		return "", err
+	}
+
+	in, err := json.Marshal(request{Action: "get", Key: key})
+	if err != nil {
+		return "", err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+	setProcessGroup(cmd)
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return "", fmt.Errorf("the helper was stopped: %w", ctx.Err())
+		}
+		if msg := firstLine(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+
+	// The decoding error is not returned, it may quote the output.
+	var resp response
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return "", errors.New("the output of the helper is not a valid JSON object")
+	}
+
+	switch {
+	case resp.Error != "":
+		return "", fmt.Errorf("the helper returned an error: %s", resp.Error)
+	case resp.Value == nil:
+		return "", ErrNotFound
+	}
+
+	return *resp.Value, nil
+}
+
+// firstLine returns the first non-empty line of s, truncated.
+func firstLine(s string) string {
+	const maxLen = 200
+
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if len(line) > maxLen {
+			line = line[:maxLen] + "..."
+		}
+		return line
+	}
+
+	return ""
+}
@@ -0,0 +1,19 @@
+//go:build !unix
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+package credhelper
+
+import "os/exec"
+
+// setProcessGroup does nothing, only the helper is killed when the context is
+// done. The processes it forks are left running, waitDelay stops waiting for
+// them.
+func setProcessGroup(_ *exec.Cmd) {}
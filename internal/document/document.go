@@ -0,0 +1,274 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package document reads JSON and YAML files and selects values with JSON
+// Pointers (RFC 6901).
+package document
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/fsutil"
+)
+
+// Format is the format of a document.
+type Format int
+
+const (
+	// JSON is the JSON format.
+	JSON Format = iota
+	// YAML is the YAML format.
+	YAML
+)
+
+// String returns the name of the format.
+func (f Format) String() string {
+	if f == YAML {
+		return "YAML"
+	}
+
+	return "JSON"
+}
+
+// ErrNotFound is returned by Lookup if the pointer does not reference an
+// existing value.
+var ErrNotFound = errors.New("value not found")
+
+// ErrInvalidPointer is returned by Lookup if the JSON Pointer is invalid.
+var ErrInvalidPointer = errors.New("invalid JSON Pointer")
+
+// Load reads and decodes the file. A leading ~ in the path is expanded to the
+// home directory of the user.
+func Load(path string, format Format) (any, error) {
+	p, err := fsutil.ExpandHome(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc any
+	switch format {
+	case YAML:
+		if err := yaml.Unmarshal(b, &doc); err != nil {
+			return nil, err
+		}
+	default:
+		// Numbers are decoded as json.Number to keep the precision of large
+		// integers.
+		d := json.NewDecoder(bytes.NewReader(b))
+		d.UseNumber()
+		if err := d.Decode(&doc); err != nil {
+			return nil, err
+		}
+		if _, err := d.Token(); !errors.Is(err, io.EOF) {
+			return nil, errors.New("invalid character after top-level value")
+		}
+	}
+
+	return doc, nil
+}
+
+// Select loads the file and returns the value referenced by the JSON Pointer,
+// converted by convert. The errors are returned as attribute diagnostics of
+// the attribute p.
+func Select[T any](p path.Path, file, pointer string, format Format, convert func(any) (T, error)) (value T, diags diag.Diagnostics) {
+	doc, err := Load(file, format)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		diags.AddAttributeError(p, "File not found", fmt.Sprintf("The %s file %s does not exist", format, file))
+		return value, diags
+	case err != nil:
+		diags.AddAttributeError(p, "Unable to read file", fmt.Sprintf("The %s file %s cannot be read: %s", format, file, err))
+		return value, diags
+	}
+
+	v, err := Lookup(doc, pointer)
+	switch {
+	case errors.Is(err, ErrInvalidPointer):
+		diags.AddAttributeError(p, "Invalid JSON Pointer", err.Error())
+		return value, diags
+	case err != nil:
+		diags.AddAttributeError(p, "Value not found", fmt.Sprintf("The JSON Pointer %q does not reference a value in the %s file %s (%s)", pointer, format, file, err))
+		return value, diags
+	}
+
+	value, err = convert(v)
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid value type", fmt.Sprintf("The value at %q in the %s file %s %s", pointer, format, file, err))
+	}
+
+	return value, diags
+}
+
+// Lookup returns the value referenced by the JSON Pointer. The empty pointer
+// references the whole document.
+func Lookup(doc any, pointer string) (any, error) {
+	if pointer == "" {
+		return doc, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("%w %q: it must be empty or start with /", ErrInvalidPointer, pointer)
+	}
+
+	cur := doc
+	parent := ""
+	for _, token := range strings.Split(pointer[1:], "/") {
+		key := strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		var ok bool
+		switch v := cur.(type) {
+		case map[string]any:
+			cur, ok = v[key]
+		case map[any]any:
+			cur, ok = v[key]
+		case []any:
+			i, err := index(key)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q is an array, %q is not a valid index", ErrNotFound, parentName(parent), key)
+			}
+			ok = i < len(v)
+			if ok {
+				cur = v[i]
+			}
+		default:
+			return nil, fmt.Errorf("%w: %q is %s, it has no member %q", ErrNotFound, parentName(parent), Kind(cur), key)
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("%w: %q has no member %q", ErrNotFound, parentName(parent), key)
+		}
+
+		parent += "/" + token
+	}
+
+	return cur, nil
+}
+
+// index parses an array index, without leading zeros.
+func index(s string) (int, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, strconv.ErrSyntax
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, strconv.ErrSyntax
+		}
+	}
+
+	return strconv.Atoi(s)
+}
+
+func parentName(parent string) string {
+	if parent == "" {
+		return "/"
+	}
+
+	return parent
+}
+
+// Kind returns the JSON type of the value with an article (e.g. "a string",
+// "an object").
+func Kind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case json.Number, int, int64, uint64, float64:
+		return "a number"
+	case map[string]any, map[any]any:
+		return "an object"
+	case []any:
+		return "an array"
+	default:
+		return fmt.Sprintf("a %T", v)
+	}
+}
+
+// String converts the value to a string.
+func String(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("is %s, expected a string", Kind(v))
+	}
+
+	return s, nil
+}
+
+// Bool converts the value to a boolean.
+func Bool(v any) (bool, error) {
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("is %s, expected a boolean", Kind(v))
+	}
+
+	return b, nil
+}
+
+// Int64 converts the value to an int64. The numbers with a fractional part
+// or out of the int64 range are rejected.
+func Int64(v any) (int64, error) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, fmt.Errorf("is %d, out of the int64 range", n)
+		}
+		return int64(n), nil
+	case json.Number:
+		if i, err := n.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := n.Float64()
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("is %s, not a valid number", n)
+		}
+		return floatToInt64(f, n.String())
+	case float64:
+		return floatToInt64(n, strconv.FormatFloat(n, 'g', -1, 64))
+	default:
+		return 0, fmt.Errorf("is %s, expected a number", Kind(v))
+	}
+}
+
+func floatToInt64(f float64, s string) (int64, error) {
+	switch {
+	// float64(math.MaxInt64) is rounded up to 2^63, which overflows.
+	case math.IsInf(f, 0) || f < math.MinInt64 || f >= math.MaxInt64:
+		return 0, fmt.Errorf("is %s, out of the int64 range", s)
+	case f != math.Trunc(f):
+		return 0, fmt.Errorf("is %s, not a whole number", s)
+	}
+
+	return int64(f), nil
+}
@@ -0,0 +1,253 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package dotenv parses dotenv files (e.g. ".env").
+package dotenv
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/fsutil"
+)
+
+type entry struct {
+	once   sync.Once
+	values map[string]string
+	err    error
+}
+
+// cache holds the parsed files, by absolute path.
+var cache sync.Map
+
+// Load returns the variables of the dotenv file. A leading ~ in the path is
+// expanded to the home directory of the user. A file that does not exist has
+// no variables.
+//
+// The file is parsed once per process, the result is cached.
+func Load(path string) (map[string]string, error) {
+	p, err := fsutil.ExpandHome(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err = filepath.Abs(p)
+	if err != nil {
+		return nil, err
+	}
+
+	v, _ := cache.LoadOrStore(p, &entry{})
+	e := v.(*entry)
+	e.once.Do(func() {
+		b, err := os.ReadFile(p)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			e.values = map[string]string{}
+		case err != nil:
+			e.err = err
+		default:
+			e.values, e.err = Parse(string(b))
+		}
+	})
+
+	return e.values, e.err
+}
+
+// Parse parses the content of a dotenv file. The following syntax is
+// supported:
+//
+//   - KEY=value, with an optional "export " prefix.
+//   - Comments, on their own line or after a value (preceded by a space).
+//   - Single-quoted values, taken literally.
+//   - Double-quoted values, with the \n, \r, \t, \", \\ and \$ escapes.
+//
+// Quoted values can span multiple lines. The last definition of a key wins.
+func Parse(s string) (map[string]string, error) {
+	p := &parser{s: strings.ReplaceAll(s, "\r\n", "\n"), line: 1}
+	values := map[string]string{}
+
+	for {
+		p.skipBlankAndComments()
+		if p.eof() {
+			return values, nil
+		}
+
+		line := p.line
+		key, value, err := p.assignment()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		values[key] = value
+	}
+}
+
+type parser struct {
+	s    string
+	pos  int
+	line int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *parser) peek() byte {
+	return p.s[p.pos]
+}
+
+func (p *parser) next() byte {
+	c := p.s[p.pos]
+	p.pos++
+	if c == '\n' {
+		p.line++
+	}
+
+	return c
+}
+
+// skipSpaces skips the spaces and tabs.
+func (p *parser) skipSpaces() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+// skipLine skips the rest of the line, including the newline.
+func (p *parser) skipLine() {
+	for !p.eof() {
+		if p.next() == '\n' {
+			return
+		}
+	}
+}
+
+func (p *parser) skipBlankAndComments() {
+	for !p.eof() {
+		p.skipSpaces()
+		switch {
+		case p.eof():
+			return
+		case p.peek() == '\n' || p.peek() == '#':
+			p.skipLine()
+		default:
+			return
+		}
+	}
+}
+
+// assignment parses a KEY=value line.
+func (p *parser) assignment() (key, value string, err error) {
+	key = p.name()
+	if key == "export" && !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.skipSpaces()
+		key = p.name()
+	}
+
+	if key == "" {
+		return "", "", errors.New("expected a variable name")
+	}
+
+	p.skipSpaces()
+	if p.eof() || p.peek() != '=' {
+		return "", "", fmt.Errorf("expected = after %s", key)
+	}
+	p.pos++
+	p.skipSpaces()
+
+	if p.eof() {
+		return key, "", nil
+	}
+
+	switch p.peek() {
+	case '\'', '"':
+		value, err = p.quoted()
+		if err != nil {
+			return "", "", fmt.Errorf("value of %s: %w", key, err)
+		}
+
+		p.skipSpaces()
+		switch {
+		case p.eof():
+		case p.peek() == '\n' || p.peek() == '#':
+			p.skipLine()
+		default:
+			return "", "", fmt.Errorf("value of %s: unexpected character after the closing quote", key)
+		}
+	default:
+		value = p.unquoted()
+	}
+
+	return key, value, nil
+}
+
+func (p *parser) name() string {
+	start := p.pos
+	for !p.eof() {
+		c := p.peek()
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (p.pos == start || c < '0' || c > '9') {
+			break
+		}
+		p.pos++
+	}
+
+	return p.s[start:p.pos]
+}
+
+// unquoted parses a value up to the end of the line or a comment.
+func (p *parser) unquoted() string {
+	start := p.pos
+	for !p.eof() && p.peek() != '\n' {
+		if p.peek() == '#' && (p.s[p.pos-1] == ' ' || p.s[p.pos-1] == '\t') {
+			break
+		}
+		p.pos++
+	}
+	value := strings.TrimSpace(p.s[start:p.pos])
+	p.skipLine()
+
+	return value
+}
+
+// quoted parses a single or double-quoted value.
+func (p *parser) quoted() (string, error) {
+	quote := p.next()
+
+	var b strings.Builder
+	for !p.eof() {
+		c := p.next()
+		switch {
+		case c == quote:
+			return b.String(), nil
+		case c == '\\' && quote == '"' && !p.eof():
+			e := p.next()
+			switch e {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\\', '$':
+				b.WriteByte(e)
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(e)
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return "", errors.New("unterminated quoted value")
+}
@@ -0,0 +1,142 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package quantity parses quantities with units such as "10GiB" or "500m".
+package quantity
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// ErrNegative is returned by Parse if the quantity is negative.
+var ErrNegative = errors.New("negative quantity")
+
+var (
+	// Bytes are the size units, in bytes. Kubernetes-style suffixes without
+	// "B" are accepted too.
+	Bytes = map[string]int64{
+		"": 1, "B": 1,
+		"k": 1e3, "K": 1e3, "kB": 1e3, "KB": 1e3,
+		"M": 1e6, "MB": 1e6,
+		"G": 1e9, "GB": 1e9,
+		"T": 1e12, "TB": 1e12,
+		"P": 1e15, "PB": 1e15,
+		"E": 1e18, "EB": 1e18,
+		"Ki": 1 << 10, "KiB": 1 << 10,
+		"Mi": 1 << 20, "MiB": 1 << 20,
+		"Gi": 1 << 30, "GiB": 1 << 30,
+		"Ti": 1 << 40, "TiB": 1 << 40,
+		"Pi": 1 << 50, "PiB": 1 << 50,
+		"Ei": 1 << 60, "EiB": 1 << 60,
+	}
+
+	// CPU are the CPU units, in millicores.
+	CPU = map[string]int64{
+		"": 1000, "m": 1,
+	}
+
+	// decimalRegexp matches an optionally signed decimal number, with
+	// underscores between the digits, followed by an optional unit.
+	decimalRegexp = regexp.MustCompile(`^([+-]?(?:[0-9]+(?:_[0-9]+)*(?:\.[0-9]+(?:_[0-9]+)*)?|\.[0-9]+(?:_[0-9]+)*))\s*([A-Za-z]*)$`)
+
+	// prefixedRegexp matches an optionally signed integer written in
+	// hexadecimal, octal or binary. The hexadecimal digits and the units share
+	// letters (e.g. "0x1B"), so the unit must be separated by a space.
+	prefixedRegexp = regexp.MustCompile(`^([+-]?0(?:[xX][0-9a-fA-F_]+|[oO][0-7_]+|[bB][01_]+))(?:\s+([A-Za-z]+))?$`)
+
+	// unsignedDecimalRegexp matches an unsigned decimal number followed by an
+	// optional unit.
+	unsignedDecimalRegexp = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?|\.[0-9]+)\s*([A-Za-z]*)$`)
+)
+
+// Parse parses the quantity and returns its value in the base unit of the
+// given units (e.g. bytes for Bytes).
+//
+// The number is read in base 10, even with leading zeros (e.g. "010GB" is
+// 10GB). Integers may also be written in hexadecimal, octal or binary with
+// the 0x, 0o or 0b prefix; the unit must then be separated by a space (e.g.
+// "0x10 GiB"), as "0x10EB" is the hexadecimal number 0x10EB. Underscores are
+// accepted between the digits.
+func Parse(s string, units map[string]int64) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+
+	if m := prefixedRegexp.FindStringSubmatch(s); m != nil {
+		i, ok := new(big.Int).SetString(m[1], 0)
+		if !ok {
+			return nil, fmt.Errorf("the value %q is not a valid quantity: invalid number %q", s, m[1])
+		}
+		return applyUnit(s, new(big.Rat).SetInt(i), m[2], units)
+	}
+
+	m := decimalRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("the value %q is not a valid quantity", s)
+	}
+
+	q, err := parseDecimal(strings.ReplaceAll(m[1], "_", ""))
+	if err != nil {
+		return nil, fmt.Errorf("the value %q is not a valid quantity: %w", s, err)
+	}
+
+	return applyUnit(s, q, m[2], units)
+}
+
+// ParseDecimal parses the quantity like Parse, but only accepts an unsigned
+// decimal number (e.g. "1.5GiB"), without base prefix nor underscores.
+func ParseDecimal(s string, units map[string]int64) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+
+	m := unsignedDecimalRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("the value %q is not a valid quantity", s)
+	}
+
+	q, err := parseDecimal(m[1])
+	if err != nil {
+		return nil, fmt.Errorf("the value %q is not a valid quantity: %w", s, err)
+	}
+
+	return applyUnit(s, q, m[2], units)
+}
+
+// applyUnit returns the quantity q expressed in unit in the base unit.
+func applyUnit(s string, q *big.Rat, unit string, units map[string]int64) (*big.Rat, error) {
+	multiplier, ok := units[unit]
+	if !ok {
+		return nil, fmt.Errorf("the value %q has an unknown unit %q", s, unit)
+	}
+
+	if q.Sign() < 0 {
+		return nil, fmt.Errorf("the value %q is a %w", s, ErrNegative)
+	}
+
+	return q.Mul(q, new(big.Rat).SetInt64(multiplier)), nil
+}
+
+// parseDecimal parses a decimal number in base 10. big.Rat.SetString is not
+// used for integers as it reads a leading zero as an octal prefix.
+func parseDecimal(s string) (*big.Rat, error) {
+	intPart, fracPart, _ := strings.Cut(strings.TrimLeft(s, "+-"), ".")
+
+	n, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if intPart+fracPart == "" || !ok {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+
+	q := new(big.Rat).SetFrac(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(fracPart))), nil))
+	if strings.HasPrefix(s, "-") {
+		q.Neg(q)
+	}
+
+	return q, nil
+}
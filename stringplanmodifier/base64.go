@@ -0,0 +1,145 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// Base64Option configures the Base64 plan modifiers.
+type Base64Option func(*base64Options)
+
+type base64Options struct {
+	encoding *base64.Encoding
+	name     string
+}
+
+// Base64URLEncoding uses the URL-safe alphabet (RFC 4648 section 5) instead
+// of the standard one.
+func Base64URLEncoding() Base64Option {
+	return func(o *base64Options) {
+		o.encoding = base64.URLEncoding
+		o.name = "URL"
+	}
+}
+
+func newBase64Options(opts []Base64Option) *base64Options {
+	o := &base64Options{
+		encoding: base64.StdEncoding,
+		name:     "standard",
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// Base64Encode returns a plan modifier that plans the base64 encoding of the
+// configured value. The state value is kept if it decodes to the configured
+// value, so padding or line-wrapping differences are ignored.
+func Base64Encode(opts ...Base64Option) planmodifier.String {
+	o := newBase64Options(opts)
+	description := fmt.Sprintf("Encode the value in base64 (%s encoding)", o.name)
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			v := req.ConfigValue.ValueString()
+
+			if !req.StateValue.IsNull() && !req.StateValue.IsUnknown() {
+				if b, err := decodeBase64(req.StateValue.ValueString(), o); err == nil && string(b) == v {
+					resp.Value = req.StateValue
+					return
+				}
+			}
+
+			resp.Value = types.StringValue(o.encoding.EncodeToString([]byte(v)))
+		},
+		description,
+		description,
+	)
+}
+
+// Base64Decode returns a plan modifier that plans the decoded value of the
+// base64 configured value. An error is returned if the value is not valid
+// base64 or does not decode to a UTF-8 string.
+func Base64Decode(opts ...Base64Option) planmodifier.String {
+	o := newBase64Options(opts)
+	description := fmt.Sprintf("Decode the base64 value (%s encoding)", o.name)
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			b, err := decodeBase64(req.ConfigValue.ValueString(), o)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid base64 value", err.Error())
+				resp.Value = req.ConfigValue
+				return
+			}
+
+			if !utf8.Valid(b) {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid base64 value", "The decoded value is not a valid UTF-8 string")
+				resp.Value = req.ConfigValue
+				return
+			}
+
+			resp.Value = types.StringValue(string(b))
+		},
+		description,
+		description,
+	)
+}
+
+// Base64SemanticEquality returns a plan modifier that keeps the state value
+// if the configured and state values decode to the same bytes. An error is
+// returned if the configured value is not valid base64.
+func Base64SemanticEquality(opts ...Base64Option) planmodifier.String {
+	o := newBase64Options(opts)
+	description := fmt.Sprintf("Compare base64 values by their decoded content (%s encoding)", o.name)
+
+	return setSemanticEqualityStringFunc(
+		func(s string) (string, error) {
+			b, err := decodeBase64(s, o)
+			if err != nil {
+				return "", err
+			}
+			return base64.StdEncoding.EncodeToString(b), nil
+		},
+		description,
+		description,
+	)
+}
+
+// decodeBase64 decodes the value ignoring whitespace (line-wrapping) and
+// missing padding.
+func decodeBase64(s string, o *base64Options) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	b, err := o.encoding.WithPadding(base64.NoPadding).DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, fmt.Errorf("the value is not valid base64 (%s encoding): %w", o.name, err)
+	}
+
+	return b, nil
+}
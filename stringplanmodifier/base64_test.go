@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestBase64PlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		modifier    planmodifier.String
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"encode unknown String": {
+			val:         types.StringUnknown(),
+			modifier:    stringplanmodifier.Base64Encode(),
+			exceptedVal: types.StringNull(),
+		},
+		"encode null String": {
+			val:         types.StringNull(),
+			modifier:    stringplanmodifier.Base64Encode(),
+			exceptedVal: types.StringNull(),
+		},
+		"encode": {
+			val:         types.StringValue("#cloud-config\n"),
+			modifier:    stringplanmodifier.Base64Encode(),
+			exceptedVal: types.StringValue("I2Nsb3VkLWNvbmZpZwo="),
+		},
+		"encode URL": {
+			val:         types.StringValue("??>>"),
+			modifier:    stringplanmodifier.Base64Encode(stringplanmodifier.Base64URLEncoding()),
+			exceptedVal: types.StringValue("Pz8-Pg=="),
+		},
+		"encode keep unpadded state": {
+			val:         types.StringValue("#cloud-config\n"),
+			state:       types.StringValue("I2Nsb3VkLWNv\nbmZpZwo"),
+			modifier:    stringplanmodifier.Base64Encode(),
+			exceptedVal: types.StringValue("I2Nsb3VkLWNv\nbmZpZwo"),
+		},
+		"encode different state": {
+			val:         types.StringValue("#cloud-config\n"),
+			state:       types.StringValue("Zm9v"),
+			modifier:    stringplanmodifier.Base64Encode(),
+			exceptedVal: types.StringValue("I2Nsb3VkLWNvbmZpZwo="),
+		},
+		"decode": {
+			val:         types.StringValue("I2Nsb3VkLWNv\nbmZpZwo="),
+			modifier:    stringplanmodifier.Base64Decode(),
+			exceptedVal: types.StringValue("#cloud-config\n"),
+		},
+		"decode URL": {
+			val:         types.StringValue("Pz8-Pg"),
+			modifier:    stringplanmodifier.Base64Decode(stringplanmodifier.Base64URLEncoding()),
+			exceptedVal: types.StringValue("??>>"),
+		},
+		"decode invalid": {
+			val:         types.StringValue("not base64!"),
+			modifier:    stringplanmodifier.Base64Decode(),
+			exceptedVal: types.StringValue("not base64!"),
+			expectError: true,
+		},
+		"decode binary": {
+			val:         types.StringValue("//79"),
+			modifier:    stringplanmodifier.Base64Decode(),
+			exceptedVal: types.StringValue("//79"),
+			expectError: true,
+		},
+		"semantic equality": {
+			val:         types.StringValue("I2Nsb3VkLWNvbmZpZwo="),
+			state:       types.StringValue("I2Nsb3VkLWNv\r\nbmZpZwo"),
+			modifier:    stringplanmodifier.Base64SemanticEquality(),
+			exceptedVal: types.StringValue("I2Nsb3VkLWNv\r\nbmZpZwo"),
+		},
+		"semantic equality different": {
+			val:         types.StringValue("I2Nsb3VkLWNvbmZpZwo="),
+			state:       types.StringValue("Zm9v"),
+			modifier:    stringplanmodifier.Base64SemanticEquality(),
+			exceptedVal: types.StringValue("I2Nsb3VkLWNvbmZpZwo="),
+		},
+		"semantic equality invalid": {
+			val:         types.StringValue("Pz8-Pg"),
+			state:       types.StringValue("Zm9v"),
+			modifier:    stringplanmodifier.Base64SemanticEquality(),
+			exceptedVal: types.StringValue("Pz8-Pg"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			test.modifier.PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -40,6 +40,12 @@ type DefaultFuncResponse struct {
 
 	// Value is the value to use by default if the attribute is not configured.
 	Value string
+
+	// Null sets the plan value to null instead of Value.
+	Null bool
+
+	// Unknown sets the plan value to unknown instead of Value.
+	Unknown bool
 }
 
 // setDefaultFunc returns a plan modifier that conditionally requires
@@ -98,5 +104,12 @@ func (m defaultFuncPlanModifier) PlanModifyString(ctx context.Context, req planm
 	m.f(ctx, req, funcResp)
 
 	resp.Diagnostics.Append(funcResp.Diagnostics...)
-	resp.PlanValue = types.StringValue(funcResp.Value)
+	switch {
+	case funcResp.Null:
+		resp.PlanValue = types.StringNull()
+	case funcResp.Unknown:
+		resp.PlanValue = types.StringUnknown()
+	default:
+		resp.PlanValue = types.StringValue(funcResp.Value)
+	}
 }
@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// normalizeFunc returns the normalized form of a string value or an error if
+// the value cannot be parsed.
+type normalizeFunc func(string) (string, error)
+
+// setNormalizeStringFunc
+//
+// Plan the normalized configured value. The state value is kept if it
+// normalizes to the same value, so no diff is shown.
+func setNormalizeStringFunc(f normalizeFunc, description, markdownDescription string) planmodifier.String {
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			v, ok := normalizeConfigValue(f, req, resp)
+			if !ok {
+				return
+			}
+
+			if stateEqual(f, req.StateValue, v) {
+				resp.Value = req.StateValue
+				return
+			}
+
+			resp.Value = types.StringValue(v)
+		},
+		description,
+		markdownDescription,
+	)
+}
+
+// setSemanticEqualityStringFunc
+//
+// Plan the configured value as is, unless the state value is semantically
+// equal (both values normalize to the same value), in which case the state
+// value is kept.
+func setSemanticEqualityStringFunc(f normalizeFunc, description, markdownDescription string) planmodifier.String {
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			v, ok := normalizeConfigValue(f, req, resp)
+			if !ok {
+				return
+			}
+
+			if stateEqual(f, req.StateValue, v) {
+				resp.Value = req.StateValue
+				return
+			}
+
+			resp.Value = req.ConfigValue
+		},
+		description,
+		markdownDescription,
+	)
+}
+
+// normalizeConfigValue normalizes the configured value. An attribute error is
+// added to the response if the value cannot be normalized.
+func normalizeConfigValue(f normalizeFunc, req planmodifier.StringRequest, resp *StringChangeFuncResponse) (string, bool) {
+	v, err := f(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid value", err.Error())
+		resp.Value = req.ConfigValue
+		return "", false
+	}
+
+	return v, true
+}
+
+// stateEqual returns true if the state value is known and normalizes to the
+// given value.
+func stateEqual(f normalizeFunc, state types.String, normalized string) bool {
+	if state.IsNull() || state.IsUnknown() {
+		return false
+	}
+
+	v, err := f(state.ValueString())
+
+	return err == nil && v == normalized
+}
@@ -24,26 +24,194 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// EnvVarOption configures the SetDefaultEnvVar plan modifier.
+type EnvVarOption func(*envVarOptions)
+
+// unsetBehavior is the behavior of SetDefaultEnvVar when the environment
+// variable is not set.
+type unsetBehavior int
+
+const (
+	unsetError unsetBehavior = iota
+	unsetWarning
+	unsetNull
+	unsetUnknown
+	unsetFallback
 )
 
+type envVarOptions struct {
+	onUnset    unsetBehavior
+	fallback   string
+	allowEmpty bool
+	validators []validator.String
+}
+
+// EnvVarUnsetError returns an error diagnostic if the environment variable is
+// not set. This is the default.
+func EnvVarUnsetError() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetError
+	}
+}
+
+// EnvVarUnsetWarning returns a warning diagnostic and leaves the value null
+// if the environment variable is not set.
+func EnvVarUnsetWarning() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetWarning
+	}
+}
+
+// EnvVarUnsetNull leaves the value null if the environment variable is not
+// set.
+func EnvVarUnsetNull() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetNull
+	}
+}
+
+// EnvVarUnsetUnknown leaves the value unknown if the environment variable is
+// not set, so the provider can compute it.
+func EnvVarUnsetUnknown() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetUnknown
+	}
+}
+
+// EnvVarUnsetFallback uses the fallback value if the environment variable is
+// not set.
+func EnvVarUnsetFallback(fallback string) EnvVarOption {
+	return func(o *envVarOptions) {
+		o.onUnset = unsetFallback
+		o.fallback = fallback
+	}
+}
+
+// EnvVarAllowEmpty considers an environment variable set to an empty string
+// as set. By default, it is considered as not set.
+func EnvVarAllowEmpty() EnvVarOption {
+	return func(o *envVarOptions) {
+		o.allowEmpty = true
+	}
+}
+
+// EnvVarValidators runs the validators on the value read from the environment
+// variable. Values injected at plan time are not checked by the schema
+// validators of the attribute.
+func EnvVarValidators(validators ...validator.String) EnvVarOption {
+	return func(o *envVarOptions) {
+		o.validators = append(o.validators, validators...)
+	}
+}
+
+func newEnvVarOptions(opts []EnvVarOption) *envVarOptions {
+	o := &envVarOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// lookup returns the value of the environment variable and whether it is
+// considered as set.
+func (o *envVarOptions) lookup(envVar string) (string, bool) {
+	return o.isSet(os.LookupEnv(envVar))
+}
+
+// isSet returns the value and whether it is considered as set.
+func (o *envVarOptions) isSet(v string, ok bool) (string, bool) {
+	if !ok || (v == "" && !o.allowEmpty) {
+		return "", false
+	}
+
+	return v, true
+}
+
+// unset applies the behavior for an environment variable not set.
+func (o *envVarOptions) unset(envVar string, resp *DefaultFuncResponse) {
+	switch o.onUnset {
+	case unsetWarning:
+		resp.Diagnostics.AddWarning("Environment variable not set", fmt.Sprintf("The environment variable %s is not set", envVar))
+		resp.Null = true
+	case unsetNull:
+		resp.Null = true
+	case unsetUnknown:
+		resp.Unknown = true
+	case unsetFallback:
+		resp.Value = o.fallback
+	case unsetError:
+		resp.Diagnostics.AddError("Environment variable not set", fmt.Sprintf("The environment variable %s is not set", envVar))
+	}
+}
+
+// validate runs the validators on the value read from the environment
+// variable. The diagnostics of the validators are returned with the name of
+// the environment variable.
+func (o *envVarOptions) validate(ctx context.Context, req planmodifier.StringRequest, envVar string, resp *DefaultFuncResponse) {
+	for _, v := range o.validators {
+		vResp := &validator.StringResponse{}
+		v.ValidateString(ctx, validator.StringRequest{
+			Path:           req.Path,
+			PathExpression: req.PathExpression,
+			Config:         req.Config,
+			ConfigValue:    types.StringValue(resp.Value),
+		}, vResp)
+
+		for _, d := range vResp.Diagnostics {
+			detail := fmt.Sprintf("The value of the environment variable %s is invalid: %s", envVar, d.Detail())
+			switch d.Severity() {
+			case diag.SeverityError:
+				resp.Diagnostics.AddAttributeError(req.Path, d.Summary(), detail)
+			case diag.SeverityWarning:
+				resp.Diagnostics.AddAttributeWarning(req.Path, d.Summary(), detail)
+			}
+		}
+	}
+}
+
 // SetDefaultEnvVar returns a plan modifier that conditionally requires
 // resource replacement if:
 //
 //   - The resource is planned for update.
 //   - The plan and state values are not equal.
 //   - The plan or state values are not null or known
-func SetDefaultEnvVar(envVar string) planmodifier.String {
+//
+// An error is returned if the environment variable is not set, unless another
+// behavior is given (see EnvVarUnsetWarning, EnvVarUnsetNull,
+// EnvVarUnsetUnknown and EnvVarUnsetFallback).
+func SetDefaultEnvVar(envVar string, opts ...EnvVarOption) planmodifier.String {
 	return setDefaultFunc(
-		func(_ context.Context, _ planmodifier.StringRequest, resp *DefaultFuncResponse) {
-			v := os.Getenv(envVar)
-			if v != "" {
-				resp.Value = v
-			} else {
-				resp.Diagnostics.AddError("Environment variable not set", fmt.Sprintf("The environment variable %s is not set", envVar))
-			}
-		},
+		envVarFunc(envVar, newEnvVarOptions(opts)),
 		"Set default value from environment variable",
 		"Set default value from environment variable",
 	)
 }
+
+// envVarFunc returns a DefaultFunc that reads the value from the environment
+// variable.
+func envVarFunc(envVar string, o *envVarOptions) DefaultFunc {
+	return func(ctx context.Context, req planmodifier.StringRequest, resp *DefaultFuncResponse) {
+		v, ok := o.lookup(envVar)
+		o.resolve(ctx, req, envVar, v, ok, resp)
+	}
+}
+
+// resolve sets the value of the variable in the response, or applies the
+// behavior for a variable not set.
+func (o *envVarOptions) resolve(ctx context.Context, req planmodifier.StringRequest, name, v string, ok bool, resp *DefaultFuncResponse) {
+	if !ok {
+		o.unset(name, resp)
+		return
+	}
+
+	resp.Value = v
+	o.validate(ctx, req, name, resp)
+}
@@ -21,13 +21,16 @@ package stringplanmodifier_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
 )
@@ -119,3 +122,159 @@ func TestDefaultEnvVarModifierPlanModifyString(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultEnvVarUnsetModifierPlanModifyString(t *testing.T) {
+	const (
+		envVarName   = "TEST_VAR_UNSET"
+		emptyVarName = "TEST_VAR_EMPTY"
+	)
+
+	t.Setenv(emptyVarName, "")
+
+	testCases := map[string]struct {
+		envVar        string
+		opts          []stringplanmodifier.EnvVarOption
+		expected      types.String
+		expectError   bool
+		expectWarning bool
+	}{
+		"unset-error": {
+			envVar:      envVarName,
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"unset-warning": {
+			envVar:        envVarName,
+			opts:          []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetWarning()},
+			expected:      types.StringNull(),
+			expectWarning: true,
+		},
+		"unset-null": {
+			envVar:   envVarName,
+			opts:     []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetNull()},
+			expected: types.StringNull(),
+		},
+		"unset-unknown": {
+			envVar:   envVarName,
+			opts:     []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetUnknown()},
+			expected: types.StringUnknown(),
+		},
+		"unset-fallback": {
+			envVar:   envVarName,
+			opts:     []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetFallback("fallback")},
+			expected: types.StringValue("fallback"),
+		},
+		"empty-is-unset": {
+			envVar:   emptyVarName,
+			opts:     []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetFallback("fallback")},
+			expected: types.StringValue("fallback"),
+		},
+		"empty-allowed": {
+			envVar:      emptyVarName,
+			opts:        []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarAllowEmpty(), stringplanmodifier.EnvVarUnsetFallback("fallback")},
+			expected:    types.StringValue(""),
+			expectError: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultEnvVar(testCase.envVar, testCase.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != testCase.expectWarning {
+				t.Errorf("expected warning: %v, got: %v", testCase.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// stringValidator is a validator.String that rejects the values for which
+// invalid returns true.
+type stringValidator struct {
+	invalid func(string) bool
+}
+
+func (v stringValidator) Description(_ context.Context) string {
+	return "value must start with vdc-"
+}
+
+func (v stringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if v.invalid(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid value", "value must start with vdc-")
+	}
+}
+
+func TestDefaultEnvVarValidatorsModifierPlanModifyString(t *testing.T) {
+	const envVarName = "TEST_VAR_VALIDATED"
+
+	v := stringValidator{invalid: func(v string) bool { return !strings.HasPrefix(v, "vdc-") }}
+
+	testCases := map[string]struct {
+		envValue    string
+		expected    types.String
+		expectError bool
+	}{
+		"valid": {
+			envValue: "vdc-prod",
+			expected: types.StringValue("vdc-prod"),
+		},
+		"invalid": {
+			envValue:    "prod",
+			expected:    types.StringValue("prod"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(envVarName, testCase.envValue)
+
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultEnvVar(envVarName, stringplanmodifier.EnvVarValidators(v)).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			for _, d := range resp.Diagnostics.Errors() {
+				if !strings.Contains(d.Detail(), envVarName) {
+					t.Errorf("expected the environment variable name in the diagnostic, got: %s", d.Detail())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// ExpandEnvOption configures the SetDefaultExpandEnv plan modifier.
+type ExpandEnvOption func(*expandEnvOptions)
+
+type expandEnvOptions struct {
+	allowEmpty bool
+}
+
+// ExpandEnvAllowEmpty considers an environment variable set to an empty
+// string as set. By default, it is considered as not set, so the fallback is
+// used or an error is returned.
+func ExpandEnvAllowEmpty() ExpandEnvOption {
+	return func(o *expandEnvOptions) {
+		o.allowEmpty = true
+	}
+}
+
+// SetDefaultExpandEnv returns a plan modifier that sets the default value
+// from the template, with the environment variables expanded. The template
+// supports the following syntax:
+//
+//   - $VAR and ${VAR} are replaced by the value of the environment variable.
+//   - ${VAR:-fallback} is replaced by fallback if the environment variable is
+//     not set. The fallback can itself reference environment variables.
+//   - $$ is replaced by a literal $.
+//
+// An error is returned if an environment variable is not set and has no
+// fallback.
+func SetDefaultExpandEnv(template string, opts ...ExpandEnvOption) planmodifier.String {
+	o := &expandEnvOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return setDefaultFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *DefaultFuncResponse) {
+			e := &envExpander{allowEmpty: o.allowEmpty}
+
+			v, err := e.expand(template)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid template", fmt.Sprintf("The template %q is invalid: %s", template, err))
+				return
+			}
+
+			if len(e.missing) > 0 {
+				resp.Diagnostics.AddAttributeError(req.Path, "Environment variable not set", fmt.Sprintf("The template %q references environment variables that are not set: %s", template, strings.Join(e.missing, ", ")))
+				return
+			}
+
+			resp.Value = v
+		},
+		fmt.Sprintf("Set default value from the template %q with environment variables expanded", template),
+		fmt.Sprintf("Set default value from the template `%s` with environment variables expanded", template),
+	)
+}
+
+// envExpander expands the environment variables of a template and records
+// the variables that are not set.
+type envExpander struct {
+	allowEmpty bool
+	missing    []string
+}
+
+func (e *envExpander) expand(s string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		switch c := s[i+1]; {
+		case c == '$':
+			b.WriteByte('$')
+			i++
+		case c == '{':
+			end := closingBrace(s, i+2)
+			if end < 0 {
+				return "", fmt.Errorf("unclosed reference at position %d", i)
+			}
+
+			v, err := e.reference(s[i+2 : end])
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(v)
+			i = end
+		case isNameStart(c):
+			j := i + 1
+			for j < len(s) && isNameChar(s[j]) {
+				j++
+			}
+			b.WriteString(e.lookup(s[i+1 : j]))
+			i = j - 1
+		default:
+			b.WriteByte('$')
+		}
+	}
+
+	return b.String(), nil
+}
+
+// reference expands the content of a ${...} reference.
+func (e *envExpander) reference(ref string) (string, error) {
+	name, fallback, hasFallback := strings.Cut(ref, ":-")
+	if !isName(name) {
+		return "", fmt.Errorf("invalid environment variable name %q", name)
+	}
+
+	if !hasFallback {
+		return e.lookup(name), nil
+	}
+
+	if v, ok := e.get(name); ok {
+		return v, nil
+	}
+
+	return e.expand(fallback)
+}
+
+// lookup returns the value of the environment variable, or records it as
+// missing.
+func (e *envExpander) lookup(name string) string {
+	v, ok := e.get(name)
+	if !ok {
+		e.missing = append(e.missing, name)
+	}
+
+	return v
+}
+
+func (e *envExpander) get(name string) (string, bool) {
+	v, ok := os.LookupEnv(name)
+	if !ok || (v == "" && !e.allowEmpty) {
+		return "", false
+	}
+
+	return v, true
+}
+
+// closingBrace returns the index of the brace closing the reference starting
+// at start, or -1. Nested references are skipped.
+func closingBrace(s string, start int) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch {
+		case s[i] == '$' && i+1 < len(s) && s[i+1] == '{':
+			depth++
+			i++
+		case s[i] == '}' && depth == 0:
+			return i
+		case s[i] == '}':
+			depth--
+		}
+	}
+
+	return -1
+}
+
+func isName(s string) bool {
+	if s == "" || !isNameStart(s[0]) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if !isNameChar(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestDefaultExpandEnvModifierPlanModifyString(t *testing.T) {
+	t.Setenv("TEST_EXPAND_ORG", "my-org")
+	t.Setenv("TEST_EXPAND_HOME", "/home/user")
+	t.Setenv("TEST_EXPAND_EMPTY", "")
+
+	testCases := map[string]struct {
+		template    string
+		opts        []stringplanmodifier.ExpandEnvOption
+		expected    types.String
+		expectError bool
+	}{
+		"braces": {
+			template: "${TEST_EXPAND_ORG}-backup",
+			expected: types.StringValue("my-org-backup"),
+		},
+		"no braces": {
+			template: "$TEST_EXPAND_HOME/.cloudavenue/ca.pem",
+			expected: types.StringValue("/home/user/.cloudavenue/ca.pem"),
+		},
+		"no reference": {
+			template: "static",
+			expected: types.StringValue("static"),
+		},
+		"escaped dollar": {
+			template: "cost-$$-${TEST_EXPAND_ORG}",
+			expected: types.StringValue("cost-$-my-org"),
+		},
+		"trailing dollar": {
+			template: "price$",
+			expected: types.StringValue("price$"),
+		},
+		"fallback unused": {
+			template: "${TEST_EXPAND_ORG:-default}",
+			expected: types.StringValue("my-org"),
+		},
+		"fallback used": {
+			template: "${TEST_EXPAND_MISSING:-default}-backup",
+			expected: types.StringValue("default-backup"),
+		},
+		"nested fallback": {
+			template: "${TEST_EXPAND_MISSING:-${TEST_EXPAND_ORG}}",
+			expected: types.StringValue("my-org"),
+		},
+		"empty uses fallback": {
+			template: "${TEST_EXPAND_EMPTY:-default}",
+			expected: types.StringValue("default"),
+		},
+		"empty allowed": {
+			template: "x${TEST_EXPAND_EMPTY}x",
+			opts:     []stringplanmodifier.ExpandEnvOption{stringplanmodifier.ExpandEnvAllowEmpty()},
+			expected: types.StringValue("xx"),
+		},
+		"missing": {
+			template:    "${TEST_EXPAND_MISSING}-backup",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"empty is missing": {
+			template:    "$TEST_EXPAND_EMPTY",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"unclosed": {
+			template:    "${TEST_EXPAND_ORG",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"invalid name": {
+			template:    "${1ORG}",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultExpandEnv(testCase.template, testCase.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
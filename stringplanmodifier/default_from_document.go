@@ -0,0 +1,58 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/document"
+)
+
+// SetDefaultFromJSONFile returns a plan modifier that sets the default value
+// from the value referenced by the JSON Pointer (e.g. "/defaults/region") in
+// the JSON file. A leading ~ in the path is expanded to the home directory of
+// the user.
+//
+// An error is returned if the file cannot be read, if the pointer does not
+// reference a value or if the value is not a string.
+func SetDefaultFromJSONFile(path, pointer string) planmodifier.String {
+	return setDefaultFromDocument(path, pointer, document.JSON)
+}
+
+// SetDefaultFromYAMLFile returns a plan modifier that sets the default value
+// from the value referenced by the JSON Pointer (e.g. "/defaults/region") in
+// the YAML file. A leading ~ in the path is expanded to the home directory of
+// the user.
+//
+// An error is returned if the file cannot be read, if the pointer does not
+// reference a value or if the value is not a string.
+func SetDefaultFromYAMLFile(path, pointer string) planmodifier.String {
+	return setDefaultFromDocument(path, pointer, document.YAML)
+}
+
+func setDefaultFromDocument(path, pointer string, format document.Format) planmodifier.String {
+	return setDefaultFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *DefaultFuncResponse) {
+			v, diags := document.Select(req.Path, path, pointer, format, document.String)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+
+			resp.Value = v
+		},
+		fmt.Sprintf("Set default value from %q in the %s file %q", pointer, format, path),
+		fmt.Sprintf("Set default value from `%s` in the %s file `%s`", pointer, format, path),
+	)
+}
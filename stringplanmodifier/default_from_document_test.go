@@ -0,0 +1,133 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestDefaultFromDocumentModifierPlanModifyString(t *testing.T) {
+	dir := t.TempDir()
+
+	jsonFile := filepath.Join(dir, "settings.json")
+	if err := os.WriteFile(jsonFile, []byte(`{"defaults": {"region": "fr-paris", "storage/profile": "gold", "size": 10}, "vdcs": ["vdc-01", "vdc-02"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	yamlFile := filepath.Join(dir, "settings.yaml")
+	if err := os.WriteFile(yamlFile, []byte(`defaults:
+  region: fr-paris
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		modifier      planmodifier.String
+		expected      types.String
+		expectedError string
+	}{
+		"json": {
+			modifier: stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/region"),
+			expected: types.StringValue("fr-paris"),
+		},
+		"json escaped key": {
+			modifier: stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/storage~1profile"),
+			expected: types.StringValue("gold"),
+		},
+		"json array index": {
+			modifier: stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "/vdcs/1"),
+			expected: types.StringValue("vdc-02"),
+		},
+		"yaml": {
+			modifier: stringplanmodifier.SetDefaultFromYAMLFile(yamlFile, "/defaults/region"),
+			expected: types.StringValue("fr-paris"),
+		},
+		"type mismatch": {
+			modifier:      stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/size"),
+			expected:      types.StringValue(""),
+			expectedError: "is a number, expected a string",
+		},
+		"object": {
+			modifier:      stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults"),
+			expected:      types.StringValue(""),
+			expectedError: "is an object, expected a string",
+		},
+		"not found": {
+			modifier:      stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "/defaults/vdc"),
+			expected:      types.StringValue(""),
+			expectedError: "has no member \"vdc\"",
+		},
+		"index out of range": {
+			modifier:      stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "/vdcs/5"),
+			expected:      types.StringValue(""),
+			expectedError: "has no member \"5\"",
+		},
+		"invalid pointer": {
+			modifier:      stringplanmodifier.SetDefaultFromJSONFile(jsonFile, "defaults"),
+			expected:      types.StringValue(""),
+			expectedError: "must be empty or start with /",
+		},
+		"missing file": {
+			modifier:      stringplanmodifier.SetDefaultFromJSONFile(filepath.Join(dir, "missing.json"), "/defaults/region"),
+			expected:      types.StringValue(""),
+			expectedError: "does not exist",
+		},
+		"invalid json": {
+			modifier:      stringplanmodifier.SetDefaultFromJSONFile(yamlFile, "/defaults/region"),
+			expected:      types.StringValue(""),
+			expectedError: "cannot be read",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if testCase.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+const dotenvContent = `# Cloud Avenue settings
+export CAV_ORG=my-org
+CAV_VDC = vdc-01 # trailing comment
+
+SINGLE='literal $HOME # not a comment'
+DOUBLE="line1\nline2 \"quoted\"" # comment
+MULTI="first
+second"
+EMPTY=
+HASH=a#b
+CAV_ORG_OVERRIDE=first
+CAV_ORG_OVERRIDE=second
+`
+
+func TestDefaultFromDotenvModifierPlanModifyString(t *testing.T) {
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, ".env")
+	if err := os.WriteFile(file, []byte(dotenvContent), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidFile := filepath.Join(dir, "invalid.env")
+	if err := os.WriteFile(invalidFile, []byte("KEY=\"unterminated\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		file        string
+		key         string
+		opts        []stringplanmodifier.EnvVarOption
+		expected    types.String
+		expectError bool
+	}{
+		"export prefix": {
+			file:     file,
+			key:      "CAV_ORG",
+			expected: types.StringValue("my-org"),
+		},
+		"spaces and comment": {
+			file:     file,
+			key:      "CAV_VDC",
+			expected: types.StringValue("vdc-01"),
+		},
+		"single-quoted": {
+			file:     file,
+			key:      "SINGLE",
+			expected: types.StringValue("literal $HOME # not a comment"),
+		},
+		"double-quoted": {
+			file:     file,
+			key:      "DOUBLE",
+			expected: types.StringValue("line1\nline2 \"quoted\""),
+		},
+		"multi-line": {
+			file:     file,
+			key:      "MULTI",
+			expected: types.StringValue("first\nsecond"),
+		},
+		"hash in value": {
+			file:     file,
+			key:      "HASH",
+			expected: types.StringValue("a#b"),
+		},
+		"last definition wins": {
+			file:     file,
+			key:      "CAV_ORG_OVERRIDE",
+			expected: types.StringValue("second"),
+		},
+		"empty": {
+			file:        file,
+			key:         "EMPTY",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"empty allowed": {
+			file:     file,
+			key:      "EMPTY",
+			opts:     []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarAllowEmpty()},
+			expected: types.StringValue(""),
+		},
+		"commented out": {
+			file:        file,
+			key:         "Cloud",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"key not set with fallback": {
+			file:     file,
+			key:      "CAV_MISSING",
+			opts:     []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetFallback("fallback")},
+			expected: types.StringValue("fallback"),
+		},
+		"missing file": {
+			file:        filepath.Join(dir, "missing.env"),
+			key:         "CAV_ORG",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"missing file with null": {
+			file:     filepath.Join(dir, "missing.env"),
+			key:      "CAV_ORG",
+			opts:     []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetNull()},
+			expected: types.StringNull(),
+		},
+		"invalid file": {
+			file:        invalidFile,
+			key:         "KEY",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultFromDotenv(testCase.file, testCase.key, testCase.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDefaultFromDotenvCache(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(file, []byte("CAV_ORG=first\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	modifier := stringplanmodifier.SetDefaultFromDotenv(file, "CAV_ORG")
+
+	for _, expected := range []string{"first", "first"} {
+		request := planmodifier.StringRequest{
+			Path:        path.Root("test"),
+			StateValue:  types.StringNull(),
+			PlanValue:   types.StringUnknown(),
+			ConfigValue: types.StringNull(),
+		}
+		resp := &planmodifier.StringResponse{
+			PlanValue: request.PlanValue,
+		}
+
+		modifier.PlanModifyString(context.Background(), request, resp)
+
+		if diff := cmp.Diff(types.StringValue(expected), resp.PlanValue); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+
+		// The file is parsed once per process, the change is not seen.
+		if err := os.WriteFile(file, []byte("CAV_ORG=second\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
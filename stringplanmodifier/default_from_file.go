@@ -0,0 +1,152 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/fsutil"
+)
+
+// defaultFileMaxSize is the default maximum size of a file read by
+// SetDefaultFromFile.
+const defaultFileMaxSize = 1 << 20 // 1 MiB
+
+// FromFileOption configures the SetDefaultFromFile plan modifier.
+type FromFileOption func(*fromFileOptions)
+
+type fromFileOptions struct {
+	trimSpace bool
+	maxSize   int64
+	secret    bool
+}
+
+// FromFileTrimSpace removes the leading and trailing white spaces (including
+// the final newline) of the content of the file.
+func FromFileTrimSpace() FromFileOption {
+	return func(o *fromFileOptions) {
+		o.trimSpace = true
+	}
+}
+
+// FromFileMaxSize sets the maximum size of the file in bytes. The default is
+// 1 MiB.
+func FromFileMaxSize(size int64) FromFileOption {
+	return func(o *fromFileOptions) {
+		o.maxSize = size
+	}
+}
+
+// FromFileSecret checks that the file is not accessible by the group or the
+// others (e.g. 0600 or 0400), like SSH does for private keys. The check is
+// skipped on Windows.
+func FromFileSecret() FromFileOption {
+	return func(o *fromFileOptions) {
+		o.secret = true
+	}
+}
+
+// SetDefaultFromFile returns a plan modifier that sets the default value from
+// the content of the file. A leading ~ in the path is expanded to the home
+// directory of the user.
+//
+// An error is returned if the file does not exist, is not a regular file (e.g.
+// a named pipe), cannot be read, is larger than the maximum size or, with
+// FromFileSecret, is accessible by the group or the others. The content of the file is never included in the diagnostics.
+func SetDefaultFromFile(path string, opts ...FromFileOption) planmodifier.String {
+	o := &fromFileOptions{
+		maxSize: defaultFileMaxSize,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return setDefaultFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *DefaultFuncResponse) {
+			p, err := fsutil.ExpandHome(path)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Unable to read file", fmt.Sprintf("The path %s cannot be expanded: %s", path, err))
+				return
+			}
+
+			v, err := o.read(p)
+			switch {
+			case errors.Is(err, fs.ErrNotExist):
+				resp.Diagnostics.AddAttributeError(req.Path, "File not found", fmt.Sprintf("The file %s does not exist", p))
+			case err != nil:
+				resp.Diagnostics.AddAttributeError(req.Path, "Unable to read file", fmt.Sprintf("The file %s cannot be read: %s", p, err))
+			default:
+				resp.Value = v
+			}
+		},
+		fmt.Sprintf("Set default value from the file %q", path),
+		fmt.Sprintf("Set default value from the file `%s`", path),
+	)
+}
+
+// read returns the content of the file, after checking it.
+func (o *fromFileOptions) read(p string) (string, error) {
+	// The file is checked before it is opened, opening a named pipe blocks
+	// until a writer opens it.
+	info, err := os.Stat(p)
+	if err != nil {
+		return "", err
+	}
+
+	if !info.Mode().IsRegular() {
+		return "", errors.New("not a regular file")
+	}
+
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// The file may have been replaced after the first check.
+	info, err = f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	if !info.Mode().IsRegular() {
+		return "", errors.New("not a regular file")
+	}
+
+	if o.secret && runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return "", fmt.Errorf("permissions %04o are too open, the file must not be accessible by the group or the others (e.g. 0600)", info.Mode().Perm())
+	}
+
+	// Read one more byte to detect the files that grew after the stat.
+	b, err := io.ReadAll(io.LimitReader(f, o.maxSize+1))
+	if err != nil {
+		return "", err
+	}
+
+	if int64(len(b)) > o.maxSize {
+		return "", fmt.Errorf("the file is larger than the maximum size of %d bytes", o.maxSize)
+	}
+
+	if o.trimSpace {
+		return strings.TrimSpace(string(b)), nil
+	}
+
+	return string(b), nil
+}
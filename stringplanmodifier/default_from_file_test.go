@@ -0,0 +1,142 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestDefaultFromFileModifierPlanModifyString(t *testing.T) {
+	const content = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("USERPROFILE", dir)
+
+	writeFile := func(name, content string, perm os.FileMode) string {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte(content), perm); err != nil {
+			t.Fatal(err)
+		}
+		// WriteFile is subject to the umask.
+		if err := os.Chmod(p, perm); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	caFile := writeFile("ca.pem", content, 0o644)
+	secretFile := writeFile("secret", "s3cr3t\n", 0o600)
+	openSecretFile := writeFile("open-secret", "s3cr3t\n", 0o644)
+
+	testCases := map[string]struct {
+		path          string
+		opts          []stringplanmodifier.FromFileOption
+		expected      types.String
+		expectError   bool
+		skipOnWindows bool
+	}{
+		"file": {
+			path:     caFile,
+			expected: types.StringValue(content),
+		},
+		"home": {
+			path:     "~/ca.pem",
+			expected: types.StringValue(content),
+		},
+		"trim space": {
+			path:     caFile,
+			opts:     []stringplanmodifier.FromFileOption{stringplanmodifier.FromFileTrimSpace()},
+			expected: types.StringValue(strings.TrimSpace(content)),
+		},
+		"max size": {
+			path:     caFile,
+			opts:     []stringplanmodifier.FromFileOption{stringplanmodifier.FromFileMaxSize(int64(len(content)))},
+			expected: types.StringValue(content),
+		},
+		"too large": {
+			path:        caFile,
+			opts:        []stringplanmodifier.FromFileOption{stringplanmodifier.FromFileMaxSize(10)},
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"secret": {
+			path:     secretFile,
+			opts:     []stringplanmodifier.FromFileOption{stringplanmodifier.FromFileSecret(), stringplanmodifier.FromFileTrimSpace()},
+			expected: types.StringValue("s3cr3t"),
+		},
+		"secret too open": {
+			path:          openSecretFile,
+			opts:          []stringplanmodifier.FromFileOption{stringplanmodifier.FromFileSecret()},
+			expected:      types.StringValue(""),
+			expectError:   true,
+			skipOnWindows: true,
+		},
+		"missing": {
+			path:        filepath.Join(dir, "missing.pem"),
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"directory": {
+			path:        dir,
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if testCase.skipOnWindows && runtime.GOOS == "windows" {
+				t.Skip("permissions are not checked on Windows")
+			}
+
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultFromFile(testCase.path, testCase.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			for _, d := range resp.Diagnostics.Errors() {
+				if strings.Contains(d.Detail(), "s3cr3t") {
+					t.Errorf("the content of the file must not be in the diagnostic, got: %s", d.Detail())
+				}
+			}
+		})
+	}
+}
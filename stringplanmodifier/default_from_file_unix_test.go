@@ -0,0 +1,67 @@
+//go:build unix
+
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestDefaultFromFileFIFOModifierPlanModifyString(t *testing.T) {
+	fifo := filepath.Join(t.TempDir(), "fifo")
+	if err := syscall.Mkfifo(fifo, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	request := planmodifier.StringRequest{
+		Path:        path.Root("test"),
+		StateValue:  types.StringNull(),
+		PlanValue:   types.StringUnknown(),
+		ConfigValue: types.StringNull(),
+	}
+	resp := &planmodifier.StringResponse{
+		PlanValue: request.PlanValue,
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stringplanmodifier.SetDefaultFromFile(fifo).PlanModifyString(context.Background(), request, resp)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the plan modifier is blocked on the named pipe")
+	}
+
+	if diff := cmp.Diff(types.StringValue(""), resp.PlanValue); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("expected error: %v, got: %v", true, resp.Diagnostics)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/credhelper"
+)
+
+// defaultHelperTimeout is the default timeout of the helper called by
+// SetDefaultFromHelper.
+const defaultHelperTimeout = 10 * time.Second
+
+// HelperOption configures the SetDefaultFromHelper plan modifier.
+type HelperOption func(*helperOptions)
+
+type helperOptions struct {
+	args    []string
+	timeout time.Duration
+}
+
+// HelperArgs sets the arguments given to the helper.
+func HelperArgs(args ...string) HelperOption {
+	return func(o *helperOptions) {
+		o.args = args
+	}
+}
+
+// HelperTimeout sets the maximum duration of the helper. The default is 10
+// seconds.
+func HelperTimeout(timeout time.Duration) HelperOption {
+	return func(o *helperOptions) {
+		o.timeout = timeout
+	}
+}
+
+// SetDefaultFromHelper returns a plan modifier that sets the default value
+// from a local executable (e.g. a password manager CLI), with a JSON protocol
+// similar to the git credential helpers. The helper receives
+// {"action": "get", "key": "<key>"} on its standard input and writes
+// {"value": "..."} on its standard output.
+//
+// The helper is not run through a shell, a leading ~ in the command is
+// expanded to the home directory of the user. It is stopped, with the
+// processes it forks, after the timeout or when the request is canceled. The
+// values are cached per process.
+//
+// An error is returned if the helper fails, times out or has no value for the
+// key. The value is never included in the diagnostics.
+func SetDefaultFromHelper(command, key string, opts ...HelperOption) planmodifier.String {
+	o := &helperOptions{
+		timeout: defaultHelperTimeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return setDefaultFunc(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *DefaultFuncResponse) {
+			ctx, cancel := context.WithTimeout(ctx, o.timeout)
+			defer cancel()
+
+			v, err := credhelper.Get(ctx, command, o.args, key)
+			switch {
+			case errors.Is(err, context.DeadlineExceeded):
+				resp.Diagnostics.AddAttributeError(req.Path, "Helper timed out", fmt.Sprintf("The helper %s did not return the key %s within %s", command, key, o.timeout))
+			case errors.Is(err, credhelper.ErrNotFound):
+				resp.Diagnostics.AddAttributeError(req.Path, "Value not found", fmt.Sprintf("The helper %s has no value for the key %s", command, key))
+			case err != nil:
+				resp.Diagnostics.AddAttributeError(req.Path, "Unable to get value from helper", fmt.Sprintf("The helper %s failed to return the key %s: %s", command, key, err))
+			default:
+				resp.Value = v
+			}
+		},
+		fmt.Sprintf("Set default value from the key %s of the helper %q", key, command),
+		fmt.Sprintf("Set default value from the key `%s` of the helper `%s`", key, command),
+	)
+}
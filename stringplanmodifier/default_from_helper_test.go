@@ -0,0 +1,243 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+const helperSecret = "s3cr3t-t0k3n"
+
+// TestHelperProcess is not a real test, it is the credential helper run by
+// TestDefaultFromHelperModifierPlanModifyString.
+func TestHelperProcess(_ *testing.T) {
+	if os.Getenv("TEST_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+
+	// The process forked by the "fork" helper, it holds the standard output.
+	if os.Getenv("TEST_HELPER_CHILD") == "1" {
+		time.Sleep(10 * time.Second)
+		os.Exit(0)
+	}
+
+	var req struct {
+		Action string `json:"action"`
+		Key    string `json:"key"`
+	}
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil || req.Action != "get" {
+		fmt.Fprintln(os.Stderr, "invalid request")
+		os.Exit(2)
+	}
+
+	// Record the calls, to check the cache.
+	if f, err := os.OpenFile(os.Getenv("TEST_HELPER_CALLS"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600); err == nil {
+		fmt.Fprintln(f, req.Key)
+		f.Close()
+	}
+
+	switch {
+	case req.Key == "api_token", strings.HasPrefix(req.Key, "cached_token"):
+		fmt.Printf(`{"value": %q}`, helperSecret)
+	case req.Key == "missing":
+		fmt.Print(`{}`)
+	case req.Key == "locked":
+		fmt.Print(`{"error": "the vault is locked"}`)
+	case req.Key == "crash":
+		fmt.Fprintln(os.Stderr, "password manager not configured")
+		os.Exit(1)
+	case req.Key == "invalid":
+		fmt.Print(helperSecret)
+	case req.Key == "slow":
+		time.Sleep(10 * time.Second)
+	case req.Key == "fork":
+		child := exec.Command(os.Args[0], "-test.run=^TestHelperProcess$")
+		child.Env = append(os.Environ(), "TEST_HELPER_CHILD=1")
+		child.Stdout = os.Stdout
+		if err := child.Start(); err != nil {
+			os.Exit(1)
+		}
+		time.Sleep(10 * time.Second)
+	}
+
+	os.Exit(0)
+}
+
+func TestDefaultFromHelperModifierPlanModifyString(t *testing.T) {
+	calls := filepath.Join(t.TempDir(), "calls")
+	t.Setenv("TEST_WANT_HELPER_PROCESS", "1")
+	t.Setenv("TEST_HELPER_CALLS", calls)
+
+	helper := func(opts ...stringplanmodifier.HelperOption) []stringplanmodifier.HelperOption {
+		return append([]stringplanmodifier.HelperOption{stringplanmodifier.HelperArgs("-test.run=^TestHelperProcess$")}, opts...)
+	}
+
+	testCases := map[string]struct {
+		command     string
+		key         string
+		opts        []stringplanmodifier.HelperOption
+		expected    types.String
+		expectError bool
+	}{
+		"value": {
+			command:  os.Args[0],
+			key:      "api_token",
+			opts:     helper(),
+			expected: types.StringValue(helperSecret),
+		},
+		"no value": {
+			command:     os.Args[0],
+			key:         "missing",
+			opts:        helper(),
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"helper error": {
+			command:     os.Args[0],
+			key:         "locked",
+			opts:        helper(),
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"helper exit status": {
+			command:     os.Args[0],
+			key:         "crash",
+			opts:        helper(),
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"invalid output": {
+			command:     os.Args[0],
+			key:         "invalid",
+			opts:        helper(),
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"timeout": {
+			command:     os.Args[0],
+			key:         "slow",
+			opts:        helper(stringplanmodifier.HelperTimeout(500 * time.Millisecond)),
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"command not found": {
+			command:     filepath.Join(t.TempDir(), "missing-helper"),
+			key:         "api_token",
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultFromHelper(testCase.command, testCase.key, testCase.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			for _, d := range resp.Diagnostics {
+				if strings.Contains(d.Summary()+d.Detail(), helperSecret) {
+					t.Errorf("the value must not be in the diagnostics, got: %s", d.Detail())
+				}
+			}
+		})
+	}
+
+	t.Run("timeout with a forked process", func(t *testing.T) {
+		request := planmodifier.StringRequest{
+			Path:        path.Root("test"),
+			StateValue:  types.StringNull(),
+			PlanValue:   types.StringUnknown(),
+			ConfigValue: types.StringNull(),
+		}
+		resp := &planmodifier.StringResponse{
+			PlanValue: request.PlanValue,
+		}
+
+		start := time.Now()
+		stringplanmodifier.SetDefaultFromHelper(os.Args[0], "fork", helper(stringplanmodifier.HelperTimeout(500*time.Millisecond))...).PlanModifyString(context.Background(), request, resp)
+
+		if d := time.Since(start); d > 3*time.Second {
+			t.Errorf("expected the helper to be stopped after the timeout, took: %s", d)
+		}
+
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected error: %v, got: %v", true, resp.Diagnostics)
+		}
+	})
+
+	t.Run("cache", func(t *testing.T) {
+		// The cache is per process, the key is unique to run the test again
+		// with -count.
+		key := fmt.Sprintf("cached_token_%d", rand.Int64())
+		modifier := stringplanmodifier.SetDefaultFromHelper(os.Args[0], key, helper()...)
+
+		for i := 0; i < 2; i++ {
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			modifier.PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(types.StringValue(helperSecret), resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		}
+
+		b, err := os.ReadFile(calls)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n := strings.Count(string(b), key+"\n"); n != 1 {
+			t.Errorf("expected the helper to be called once, got: %d", n)
+		}
+	})
+}
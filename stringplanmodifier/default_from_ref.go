@@ -0,0 +1,54 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/valueref"
+)
+
+// SetDefaultFromRef returns a plan modifier that sets the default value from
+// the first reference that has a value (e.g. "env://CLOUDAVENUE_ORG",
+// "file:///etc/ca/org" or "literal://default"). The schemes are resolved by
+// the valueref package, providers can register their own schemes with
+// valueref.Register.
+//
+// An error is returned if none of the references has a value. The resolved
+// value is never included in the diagnostics.
+func SetDefaultFromRef(refs ...string) planmodifier.String {
+	return setDefaultFunc(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *DefaultFuncResponse) {
+			v, ref, found, err := valueref.Resolve(ctx, refs...)
+			switch {
+			case err != nil:
+				resp.Diagnostics.AddAttributeError(req.Path, "Unable to resolve reference", err.Error())
+				return
+			case !found:
+				resp.Diagnostics.AddAttributeError(req.Path, "No value found", fmt.Sprintf("None of the references has a value: %s", strings.Join(refs, ", ")))
+				return
+			}
+
+			scheme, _, _ := strings.Cut(ref, "://")
+			tflog.Debug(ctx, "Default value set from reference", map[string]interface{}{"scheme": scheme})
+
+			resp.Value = v
+		},
+		fmt.Sprintf("Set default value from the first reference that has a value: %s", strings.Join(refs, ", ")),
+		fmt.Sprintf("Set default value from the first reference that has a value: `%s`", strings.Join(refs, "`, `")),
+	)
+}
@@ -0,0 +1,78 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestDefaultFromRefModifierPlanModifyString(t *testing.T) {
+	t.Setenv("TEST_REF_VAR", "my-org")
+
+	testCases := map[string]struct {
+		refs        []string
+		expected    types.String
+		expectError bool
+	}{
+		"first reference": {
+			refs:     []string{"env://TEST_REF_VAR", "literal://default"},
+			expected: types.StringValue("my-org"),
+		},
+		"fallback reference": {
+			refs:     []string{"env://TEST_REF_UNSET", "literal://default"},
+			expected: types.StringValue("default"),
+		},
+		"no value": {
+			refs:        []string{"env://TEST_REF_UNSET"},
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+		"unknown scheme": {
+			refs:        []string{"unknown://TEST_REF_VAR"},
+			expected:    types.StringValue(""),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultFromRef(testCase.refs...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
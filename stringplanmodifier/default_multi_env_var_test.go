@@ -0,0 +1,120 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestDefaultMultiEnvVarModifierPlanModifyString(t *testing.T) {
+	const (
+		newEnvVarName    = "TEST_MULTI_NEW_VAR"
+		legacyEnvVarName = "TEST_MULTI_LEGACY_VAR"
+	)
+
+	testCases := map[string]struct {
+		env         map[string]string
+		opts        []stringplanmodifier.EnvVarOption
+		expected    types.String
+		expectedLog string
+		expectError bool
+	}{
+		"first variable": {
+			env:         map[string]string{newEnvVarName: "new", legacyEnvVarName: "legacy"},
+			expected:    types.StringValue("new"),
+			expectedLog: newEnvVarName,
+		},
+		"legacy variable": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: "legacy"},
+			expected:    types.StringValue("legacy"),
+			expectedLog: legacyEnvVarName,
+		},
+		"fallback": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			expected:    types.StringValue("fallback"),
+			expectedLog: "fallback",
+		},
+		"allow empty": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: "legacy"},
+			opts:        []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarAllowEmpty()},
+			expected:    types.StringValue(""),
+			expectedLog: newEnvVarName,
+		},
+		"unset null": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			opts:        []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetNull()},
+			expected:    types.StringNull(),
+			expectedLog: "No environment variable set",
+		},
+		"unset error": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: ""},
+			opts:        []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarUnsetError()},
+			expected:    types.StringValue(""),
+			expectedLog: "No environment variable set",
+			expectError: true,
+		},
+		"validators": {
+			env:         map[string]string{newEnvVarName: "", legacyEnvVarName: "legacy"},
+			opts:        []stringplanmodifier.EnvVarOption{stringplanmodifier.EnvVarValidators(stringValidator{invalid: func(v string) bool { return v == "legacy" }})},
+			expected:    types.StringValue("legacy"),
+			expectedLog: legacyEnvVarName,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// set environnement variables
+			for k, v := range testCase.env {
+				t.Setenv(k, v)
+			}
+
+			var logs bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &logs)
+
+			request := planmodifier.StringRequest{
+				StateValue:  types.StringNull(),
+				PlanValue:   types.StringUnknown(),
+				ConfigValue: types.StringNull(),
+			}
+			resp := &planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+
+			stringplanmodifier.SetDefaultMultiEnvVar([]string{newEnvVarName, legacyEnvVarName}, "fallback", testCase.opts...).PlanModifyString(ctx, request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != testCase.expectError {
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, resp.Diagnostics)
+			}
+
+			if !strings.Contains(logs.String(), testCase.expectedLog) {
+				t.Errorf("expected log to contain %q, got: %s", testCase.expectedLog, logs.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// AffixOption configures the source of the affix used by EnsurePrefix and
+// EnsureSuffix.
+type AffixOption func(*affixOptions)
+
+type affixOptions struct {
+	path *path.Path
+	f    DefaultFunc
+}
+
+// AffixFromPath reads the affix from another attribute of the plan. The
+// literal affix is used if the attribute is null.
+func AffixFromPath(p path.Path) AffixOption {
+	return func(o *affixOptions) {
+		o.path = &p
+		o.f = nil
+	}
+}
+
+// AffixFromEnvVar reads the affix from the environment variable, the same
+// way SetDefaultEnvVar does with the given options. The literal affix is used
+// if the value is left null.
+func AffixFromEnvVar(envVar string, opts ...EnvVarOption) AffixOption {
+	return AffixFromFunc(envVarFunc(envVar, newEnvVarOptions(opts)))
+}
+
+// AffixFromFunc reads the affix from the given function.
+func AffixFromFunc(f DefaultFunc) AffixOption {
+	return func(o *affixOptions) {
+		o.f = f
+		o.path = nil
+	}
+}
+
+// EnsurePrefix returns a plan modifier that adds the prefix to the
+// configured value if it does not already start with it.
+func EnsurePrefix(prefix string, opts ...AffixOption) planmodifier.String {
+	return ensureAffix(
+		prefix,
+		opts,
+		func(s, prefix string) string {
+			if strings.HasPrefix(s, prefix) {
+				return s
+			}
+			return prefix + s
+		},
+		"Ensure the value starts with the prefix",
+	)
+}
+
+// EnsureSuffix returns a plan modifier that adds the suffix to the
+// configured value if it does not already end with it.
+func EnsureSuffix(suffix string, opts ...AffixOption) planmodifier.String {
+	return ensureAffix(
+		suffix,
+		opts,
+		func(s, suffix string) string {
+			if strings.HasSuffix(s, suffix) {
+				return s
+			}
+			return s + suffix
+		},
+		"Ensure the value ends with the suffix",
+	)
+}
+
+func ensureAffix(affix string, opts []AffixOption, apply func(s, affix string) string, description string) planmodifier.String {
+	o := &affixOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.path == nil && o.f == nil {
+		description = fmt.Sprintf("%s %q", description, affix)
+	}
+
+	return setChangeStringFunc(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			a := affix
+
+			switch {
+			case o.path != nil:
+				v := types.String{}
+				resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, *o.path, &v)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// The affix is not known yet, so the final value cannot be known either.
+				if v.IsUnknown() {
+					resp.Value = types.StringUnknown()
+					return
+				}
+
+				if !v.IsNull() {
+					a = v.ValueString()
+				}
+			case o.f != nil:
+				funcResp := &DefaultFuncResponse{}
+				o.f(ctx, req, funcResp)
+
+				resp.Diagnostics.Append(funcResp.Diagnostics...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				switch {
+				case funcResp.Unknown:
+					resp.Value = types.StringUnknown()
+					return
+				case !funcResp.Null:
+					a = funcResp.Value
+				}
+			}
+
+			resp.Value = types.StringValue(apply(req.ConfigValue.ValueString(), a))
+		},
+		description,
+		description,
+	)
+}
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestEnsureAffixPlanModifyString(t *testing.T) {
+	const envVarName = "TEST_ENSURE_AFFIX_VAR"
+	t.Setenv(envVarName, "env-")
+
+	planWithTeam := func(team tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{},
+					"team": schema.StringAttribute{},
+				},
+			},
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"name": tftypes.String,
+				"team": tftypes.String,
+			}}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, nil),
+				"team": team,
+			}),
+		}
+	}
+
+	type testCase struct {
+		val         types.String
+		plan        tfsdk.Plan
+		modifier    planmodifier.String
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"null String": {
+			val:         types.StringNull(),
+			modifier:    stringplanmodifier.EnsurePrefix("team-"),
+			exceptedVal: types.StringNull(),
+		},
+		"prefix missing": {
+			val:         types.StringValue("vdc"),
+			modifier:    stringplanmodifier.EnsurePrefix("team-"),
+			exceptedVal: types.StringValue("team-vdc"),
+		},
+		"prefix present": {
+			val:         types.StringValue("team-vdc"),
+			modifier:    stringplanmodifier.EnsurePrefix("team-"),
+			exceptedVal: types.StringValue("team-vdc"),
+		},
+		"suffix missing": {
+			val:         types.StringValue("vdc"),
+			modifier:    stringplanmodifier.EnsureSuffix("-prod"),
+			exceptedVal: types.StringValue("vdc-prod"),
+		},
+		"suffix present": {
+			val:         types.StringValue("vdc-prod"),
+			modifier:    stringplanmodifier.EnsureSuffix("-prod"),
+			exceptedVal: types.StringValue("vdc-prod"),
+		},
+		"prefix from env var": {
+			val:         types.StringValue("vdc"),
+			modifier:    stringplanmodifier.EnsurePrefix("", stringplanmodifier.AffixFromEnvVar(envVarName)),
+			exceptedVal: types.StringValue("env-vdc"),
+		},
+		"prefix from unset env var": {
+			val:         types.StringValue("vdc"),
+			modifier:    stringplanmodifier.EnsurePrefix("", stringplanmodifier.AffixFromEnvVar("TEST_ENSURE_AFFIX_UNSET")),
+			exceptedVal: types.StringNull(),
+			expectError: true,
+		},
+		"prefix from path": {
+			val:         types.StringValue("vdc"),
+			plan:        planWithTeam(tftypes.NewValue(tftypes.String, "blue-")),
+			modifier:    stringplanmodifier.EnsurePrefix("team-", stringplanmodifier.AffixFromPath(path.Root("team"))),
+			exceptedVal: types.StringValue("blue-vdc"),
+		},
+		"prefix from null path": {
+			val:         types.StringValue("vdc"),
+			plan:        planWithTeam(tftypes.NewValue(tftypes.String, nil)),
+			modifier:    stringplanmodifier.EnsurePrefix("team-", stringplanmodifier.AffixFromPath(path.Root("team"))),
+			exceptedVal: types.StringValue("team-vdc"),
+		},
+		"prefix from unknown path": {
+			val:         types.StringValue("vdc"),
+			plan:        planWithTeam(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			modifier:    stringplanmodifier.EnsurePrefix("team-", stringplanmodifier.AffixFromPath(path.Root("team"))),
+			exceptedVal: types.StringUnknown(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := planmodifier.StringRequest{
+				Path:           path.Root("name"),
+				PathExpression: path.MatchRoot("name"),
+				ConfigValue:    test.val,
+				Plan:           test.plan,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			test.modifier.PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
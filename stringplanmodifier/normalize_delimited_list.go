@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// DelimitedListOption configures the NormalizeDelimitedList plan modifier.
+type DelimitedListOption func(*delimitedListOptions)
+
+type delimitedListOptions struct {
+	trim      bool
+	sort      bool
+	unique    bool
+	lowercase bool
+}
+
+// DelimitedListTrim removes the whitespace around each item and drops the
+// empty items.
+func DelimitedListTrim() DelimitedListOption {
+	return func(o *delimitedListOptions) {
+		o.trim = true
+	}
+}
+
+// DelimitedListSort sorts the items.
+func DelimitedListSort() DelimitedListOption {
+	return func(o *delimitedListOptions) {
+		o.sort = true
+	}
+}
+
+// DelimitedListUnique removes the duplicated items.
+func DelimitedListUnique() DelimitedListOption {
+	return func(o *delimitedListOptions) {
+		o.unique = true
+	}
+}
+
+// DelimitedListLowercase lowercases the items.
+func DelimitedListLowercase() DelimitedListOption {
+	return func(o *delimitedListOptions) {
+		o.lowercase = true
+	}
+}
+
+// NormalizeDelimitedList returns a plan modifier that normalizes the items of
+// a list stored in a single string and separated by sep. The state value is
+// kept if both lists contain the same items, regardless of their order.
+//
+// An error is returned if sep is empty.
+func NormalizeDelimitedList(sep string, opts ...DelimitedListOption) planmodifier.String {
+	o := &delimitedListOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	description := fmt.Sprintf("Normalize the list of items separated by %q", sep)
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			if sep == "" {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid separator", "The separator of the list must not be empty")
+				resp.Value = req.ConfigValue
+				return
+			}
+
+			items := normalizeDelimitedList(req.ConfigValue.ValueString(), sep, o)
+
+			if !req.StateValue.IsNull() && !req.StateValue.IsUnknown() {
+				stateItems := normalizeDelimitedList(req.StateValue.ValueString(), sep, o)
+				if sameItems(items, stateItems) {
+					resp.Value = req.StateValue
+					return
+				}
+			}
+
+			resp.Value = types.StringValue(strings.Join(items, sep))
+		},
+		description,
+		description,
+	)
+}
+
+func normalizeDelimitedList(s, sep string, o *delimitedListOptions) []string {
+	if s == "" {
+		return []string{}
+	}
+
+	items := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	for _, item := range strings.Split(s, sep) {
+		if o.trim {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+		}
+
+		if o.lowercase {
+			item = strings.ToLower(item)
+		}
+
+		if o.unique {
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+		}
+
+		items = append(items, item)
+	}
+
+	if o.sort {
+		sort.Strings(items)
+	}
+
+	return items
+}
+
+// sameItems returns true if both lists contain the same items, regardless of
+// their order.
+func sameItems(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestNormalizeDelimitedListPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		sep         string
+		opts        []stringplanmodifier.DelimitedListOption
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			sep:         ",",
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			sep:         ",",
+			exceptedVal: types.StringNull(),
+		},
+		"no option": {
+			val:         types.StringValue("b, a"),
+			sep:         ",",
+			exceptedVal: types.StringValue("b, a"),
+		},
+		"trim": {
+			val:         types.StringValue(" b , a ,, "),
+			sep:         ",",
+			opts:        []stringplanmodifier.DelimitedListOption{stringplanmodifier.DelimitedListTrim()},
+			exceptedVal: types.StringValue("b,a"),
+		},
+		"all options": {
+			val: types.StringValue("DNS; ntp;dns ;Ldap"),
+			sep: ";",
+			opts: []stringplanmodifier.DelimitedListOption{
+				stringplanmodifier.DelimitedListTrim(),
+				stringplanmodifier.DelimitedListSort(),
+				stringplanmodifier.DelimitedListUnique(),
+				stringplanmodifier.DelimitedListLowercase(),
+			},
+			exceptedVal: types.StringValue("dns;ldap;ntp"),
+		},
+		"keep reordered state": {
+			val:         types.StringValue("a, b, c"),
+			state:       types.StringValue("c,a,b"),
+			sep:         ",",
+			opts:        []stringplanmodifier.DelimitedListOption{stringplanmodifier.DelimitedListTrim()},
+			exceptedVal: types.StringValue("c,a,b"),
+		},
+		"different state": {
+			val:         types.StringValue("a, b, c"),
+			state:       types.StringValue("a,b"),
+			sep:         ",",
+			opts:        []stringplanmodifier.DelimitedListOption{stringplanmodifier.DelimitedListTrim()},
+			exceptedVal: types.StringValue("a,b,c"),
+		},
+		"empty separator": {
+			val:         types.StringValue("a,b"),
+			sep:         "",
+			exceptedVal: types.StringValue("a,b"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.NormalizeDelimitedList(test.sep, test.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
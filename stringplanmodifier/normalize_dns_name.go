@@ -0,0 +1,144 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// DNSNameOption configures the NormalizeDNSName plan modifier.
+type DNSNameOption func(*dnsNameOptions)
+
+type trailingDot int
+
+const (
+	trailingDotKeep trailingDot = iota
+	trailingDotAdd
+	trailingDotRemove
+)
+
+type dnsNameOptions struct {
+	trailingDot trailingDot
+	unicode     bool
+}
+
+// DNSNameAddTrailingDot adds the root dot at the end of the name.
+func DNSNameAddTrailingDot() DNSNameOption {
+	return func(o *dnsNameOptions) {
+		o.trailingDot = trailingDotAdd
+	}
+}
+
+// DNSNameRemoveTrailingDot removes the root dot at the end of the name.
+func DNSNameRemoveTrailingDot() DNSNameOption {
+	return func(o *dnsNameOptions) {
+		o.trailingDot = trailingDotRemove
+	}
+}
+
+// DNSNameToUnicode converts punycode labels to unicode instead of converting
+// internationalized labels to punycode.
+func DNSNameToUnicode() DNSNameOption {
+	return func(o *dnsNameOptions) {
+		o.unicode = true
+	}
+}
+
+// dnsNameProfile is the IDNA profile used to convert names. Underscores and
+// wildcards are allowed, as they are commonly used in DNS records.
+var dnsNameProfile = idna.New(idna.MapForLookup(), idna.Transitional(false), idna.StrictDomainName(false))
+
+// NormalizeDNSName returns a plan modifier that lowercases the DNS name and
+// converts internationalized labels to punycode (or back to unicode with
+// DNSNameToUnicode). The state value is kept if both names are equivalent,
+// regardless of the case, the trailing dot and the IDNA encoding.
+func NormalizeDNSName(opts ...DNSNameOption) planmodifier.String {
+	o := &dnsNameOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			v, err := normalizeDNSName(req.ConfigValue.ValueString(), o)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid DNS name", err.Error())
+				resp.Value = req.ConfigValue
+				return
+			}
+
+			if key, err := dnsNameKey(v); err == nil && stateEqual(dnsNameKey, req.StateValue, key) {
+				resp.Value = req.StateValue
+				return
+			}
+
+			resp.Value = types.StringValue(v)
+		},
+		"Normalize the DNS name",
+		"Normalize the DNS name",
+	)
+}
+
+func normalizeDNSName(s string, o *dnsNameOptions) (string, error) {
+	s = strings.TrimSpace(s)
+
+	hasDot := strings.HasSuffix(s, ".")
+	name := strings.TrimSuffix(s, ".")
+
+	// The profile does not check the labels, StrictDomainName is disabled.
+	if name == "" {
+		return "", errors.New("the DNS name is empty")
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return "", fmt.Errorf("the DNS name %q has an empty label", s)
+		}
+	}
+
+	var err error
+	if o.unicode {
+		name, err = dnsNameProfile.ToUnicode(name)
+	} else {
+		name, err = dnsNameProfile.ToASCII(name)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch o.trailingDot {
+	case trailingDotAdd:
+		hasDot = true
+	case trailingDotRemove:
+		hasDot = false
+	case trailingDotKeep:
+	}
+
+	if hasDot {
+		name += "."
+	}
+
+	return name, nil
+}
+
+// dnsNameKey returns the lowercase punycode form of the name without the
+// trailing dot, used to compare names.
+func dnsNameKey(s string) (string, error) {
+	return normalizeDNSName(s, &dnsNameOptions{trailingDot: trailingDotRemove})
+}
@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestNormalizeDNSNamePlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		opts        []stringplanmodifier.DNSNameOption
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"lowercase": {
+			val:         types.StringValue("WWW.Example.COM."),
+			exceptedVal: types.StringValue("www.example.com."),
+		},
+		"punycode": {
+			val:         types.StringValue("Bücher.example"),
+			exceptedVal: types.StringValue("xn--bcher-kva.example"),
+		},
+		"unicode": {
+			val:         types.StringValue("xn--bcher-kva.example"),
+			opts:        []stringplanmodifier.DNSNameOption{stringplanmodifier.DNSNameToUnicode()},
+			exceptedVal: types.StringValue("bücher.example"),
+		},
+		"remove trailing dot": {
+			val:         types.StringValue("example.com."),
+			opts:        []stringplanmodifier.DNSNameOption{stringplanmodifier.DNSNameRemoveTrailingDot()},
+			exceptedVal: types.StringValue("example.com"),
+		},
+		"add trailing dot": {
+			val:         types.StringValue("example.com"),
+			opts:        []stringplanmodifier.DNSNameOption{stringplanmodifier.DNSNameAddTrailingDot()},
+			exceptedVal: types.StringValue("example.com."),
+		},
+		"wildcard and underscore": {
+			val:         types.StringValue("*._Acme.Example.com"),
+			exceptedVal: types.StringValue("*._acme.example.com"),
+		},
+		"keep equivalent state": {
+			val:         types.StringValue("Bücher.Example."),
+			state:       types.StringValue("xn--bcher-kva.example"),
+			exceptedVal: types.StringValue("xn--bcher-kva.example"),
+		},
+		"different state": {
+			val:         types.StringValue("Bücher.Example."),
+			state:       types.StringValue("example.com"),
+			exceptedVal: types.StringValue("xn--bcher-kva.example."),
+		},
+		"empty label": {
+			val:         types.StringValue("a..b"),
+			exceptedVal: types.StringValue("a..b"),
+			expectError: true,
+		},
+		"root only": {
+			val:         types.StringValue("."),
+			exceptedVal: types.StringValue("."),
+			expectError: true,
+		},
+		"empty": {
+			val:         types.StringValue("  "),
+			exceptedVal: types.StringValue("  "),
+			expectError: true,
+		},
+		"invalid name": {
+			val:         types.StringValue("-invalid.example"),
+			exceptedVal: types.StringValue("-invalid.example"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.NormalizeDNSName(test.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// EmailOption configures the NormalizeEmail plan modifier.
+type EmailOption func(*emailOptions)
+
+type emailOptions struct {
+	lowercaseLocalPart bool
+}
+
+// EmailLowercaseLocalPart lowercases the local part of the address in
+// addition to the domain.
+func EmailLowercaseLocalPart() EmailOption {
+	return func(o *emailOptions) {
+		o.lowercaseLocalPart = true
+	}
+}
+
+// NormalizeEmail returns a plan modifier that parses the email address with
+// net/mail and plans the bare address with the domain lowercased. The display
+// name and surrounding whitespace are removed, the local part is quoted only
+// if it is not a dot-atom (e.g. "john doe"@example.com). The state value is
+// kept if both addresses are equal once normalized.
+func NormalizeEmail(opts ...EmailOption) planmodifier.String {
+	o := &emailOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return setNormalizeStringFunc(
+		func(s string) (string, error) {
+			return normalizeEmail(s, o)
+		},
+		"Normalize the email address",
+		"Normalize the email address",
+	)
+}
+
+func normalizeEmail(s string, o *emailOptions) (string, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(s))
+	if err != nil {
+		return "", fmt.Errorf("the value %q is not a valid email address: %w", s, err)
+	}
+
+	i := strings.LastIndex(addr.Address, "@")
+	local, domain := addr.Address[:i], addr.Address[i+1:]
+
+	if o.lowercaseLocalPart {
+		local = strings.ToLower(local)
+	}
+
+	if !isDotAtom(local) {
+		local = quoteLocalPart(local)
+	}
+
+	return local + "@" + strings.ToLower(domain), nil
+}
+
+// isDotAtom reports whether the local part can be written without quotes
+// (RFC 5322 dot-atom, with the UTF-8 characters of RFC 6532).
+func isDotAtom(local string) bool {
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" {
+			return false
+		}
+		for _, r := range atom {
+			if r < utf8.RuneSelf && !isAtext(byte(r)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isAtext reports whether the ASCII character c is allowed in an atom.
+func isAtext(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) >= 0
+}
+
+// quoteLocalPart returns the local part as a quoted string, net/mail returns
+// it unquoted.
+func quoteLocalPart(local string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range local {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
@@ -0,0 +1,111 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestNormalizeEmailPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		opts        []stringplanmodifier.EmailOption
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"lowercase domain": {
+			val:         types.StringValue("  John.Doe@Example.COM "),
+			exceptedVal: types.StringValue("John.Doe@example.com"),
+		},
+		"lowercase local part": {
+			val:         types.StringValue("John.Doe@Example.COM"),
+			opts:        []stringplanmodifier.EmailOption{stringplanmodifier.EmailLowercaseLocalPart()},
+			exceptedVal: types.StringValue("john.doe@example.com"),
+		},
+		"display name": {
+			val:         types.StringValue("John Doe <John.Doe@Example.com>"),
+			exceptedVal: types.StringValue("John.Doe@example.com"),
+		},
+		"quoted local part": {
+			val:         types.StringValue(`"john doe"@Example.COM`),
+			exceptedVal: types.StringValue(`"john doe"@example.com`),
+		},
+		"quoted local part with escapes": {
+			val:         types.StringValue(`"john\"doe"@Example.COM`),
+			exceptedVal: types.StringValue(`"john\"doe"@example.com`),
+		},
+		"unneeded quotes": {
+			val:         types.StringValue(`"john.doe"@Example.COM`),
+			exceptedVal: types.StringValue("john.doe@example.com"),
+		},
+		"keep equivalent state": {
+			val:         types.StringValue("John.Doe@Example.COM"),
+			state:       types.StringValue("John.Doe@example.com"),
+			exceptedVal: types.StringValue("John.Doe@example.com"),
+		},
+		"different state": {
+			val:         types.StringValue("John.Doe@Example.COM"),
+			state:       types.StringValue("jane.doe@example.com"),
+			exceptedVal: types.StringValue("John.Doe@example.com"),
+		},
+		"invalid": {
+			val:         types.StringValue("not an email"),
+			exceptedVal: types.StringValue("not an email"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.NormalizeEmail(test.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// LineEndingsOption configures the NormalizeLineEndings plan modifier.
+type LineEndingsOption func(*lineEndingsOptions)
+
+type finalNewline int
+
+const (
+	finalNewlineKeep finalNewline = iota
+	finalNewlineEnsure
+	finalNewlineRemove
+)
+
+type lineEndingsOptions struct {
+	trimTrailingWhitespace bool
+	finalNewline           finalNewline
+}
+
+// LineEndingsTrimTrailingWhitespace removes the trailing spaces and tabs of
+// each line.
+func LineEndingsTrimTrailingWhitespace() LineEndingsOption {
+	return func(o *lineEndingsOptions) {
+		o.trimTrailingWhitespace = true
+	}
+}
+
+// LineEndingsEnsureFinalNewline adds a final newline if missing.
+func LineEndingsEnsureFinalNewline() LineEndingsOption {
+	return func(o *lineEndingsOptions) {
+		o.finalNewline = finalNewlineEnsure
+	}
+}
+
+// LineEndingsRemoveFinalNewline removes all the final newlines.
+func LineEndingsRemoveFinalNewline() LineEndingsOption {
+	return func(o *lineEndingsOptions) {
+		o.finalNewline = finalNewlineRemove
+	}
+}
+
+// NormalizeLineEndings returns a plan modifier that converts CRLF and CR line
+// endings to LF. The state value is kept if the normalized texts are equal.
+func NormalizeLineEndings(opts ...LineEndingsOption) planmodifier.String {
+	o := &lineEndingsOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return setNormalizeStringFunc(
+		func(s string) (string, error) {
+			return normalizeLineEndings(s, o), nil
+		},
+		"Normalize line endings to LF",
+		"Normalize line endings to `LF`",
+	)
+}
+
+func normalizeLineEndings(s string, o *lineEndingsOptions) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+
+	if o.trimTrailingWhitespace {
+		lines := strings.Split(s, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, " \t")
+		}
+		s = strings.Join(lines, "\n")
+	}
+
+	switch o.finalNewline {
+	case finalNewlineEnsure:
+		if s != "" && !strings.HasSuffix(s, "\n") {
+			s += "\n"
+		}
+	case finalNewlineRemove:
+		s = strings.TrimRight(s, "\n")
+	case finalNewlineKeep:
+	}
+
+	return s
+}
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestNormalizeLineEndingsPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		opts        []stringplanmodifier.LineEndingsOption
+		exceptedVal types.String
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"CRLF to LF": {
+			val:         types.StringValue("line1\r\nline2\rline3\n"),
+			exceptedVal: types.StringValue("line1\nline2\nline3\n"),
+		},
+		"trim trailing whitespace": {
+			val:         types.StringValue("line1  \r\nline2\t\n"),
+			opts:        []stringplanmodifier.LineEndingsOption{stringplanmodifier.LineEndingsTrimTrailingWhitespace()},
+			exceptedVal: types.StringValue("line1\nline2\n"),
+		},
+		"ensure final newline": {
+			val:         types.StringValue("line1\r\nline2"),
+			opts:        []stringplanmodifier.LineEndingsOption{stringplanmodifier.LineEndingsEnsureFinalNewline()},
+			exceptedVal: types.StringValue("line1\nline2\n"),
+		},
+		"remove final newline": {
+			val:         types.StringValue("line1\r\nline2\r\n\r\n"),
+			opts:        []stringplanmodifier.LineEndingsOption{stringplanmodifier.LineEndingsRemoveFinalNewline()},
+			exceptedVal: types.StringValue("line1\nline2"),
+		},
+		"keep equivalent state": {
+			val:         types.StringValue("line1\r\nline2\r\n"),
+			state:       types.StringValue("line1\nline2"),
+			opts:        []stringplanmodifier.LineEndingsOption{stringplanmodifier.LineEndingsRemoveFinalNewline()},
+			exceptedVal: types.StringValue("line1\nline2"),
+		},
+		"different state": {
+			val:         types.StringValue("line1\r\nline2\r\n"),
+			state:       types.StringValue("line1\nline3\n"),
+			exceptedVal: types.StringValue("line1\nline2\n"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.NormalizeLineEndings(test.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
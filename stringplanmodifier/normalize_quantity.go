@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/quantity"
+)
+
+// QuantitySystem is the unit system used by NormalizeQuantity.
+type QuantitySystem int
+
+const (
+	// QuantitySI plans sizes with decimal units (B, kB, MB, GB, TB, PB, EB).
+	QuantitySI QuantitySystem = iota
+	// QuantityIEC plans sizes with binary units (B, KiB, MiB, GiB, TiB, PiB, EiB).
+	QuantityIEC
+	// QuantityCPU plans Kubernetes-style CPU quantities in cores ("2") or
+	// millicores ("500m").
+	QuantityCPU
+)
+
+type quantityUnit struct {
+	suffix     string
+	multiplier int64
+}
+
+var (
+	quantitySIUnits = []quantityUnit{
+		{"EB", 1e18}, {"PB", 1e15}, {"TB", 1e12}, {"GB", 1e9}, {"MB", 1e6}, {"kB", 1e3}, {"B", 1},
+	}
+	quantityIECUnits = []quantityUnit{
+		{"EiB", 1 << 60}, {"PiB", 1 << 50}, {"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10}, {"B", 1},
+	}
+	quantityCPUUnits = []quantityUnit{
+		{"", 1000}, {"m", 1},
+	}
+)
+
+func (s QuantitySystem) String() string {
+	switch s {
+	case QuantitySI:
+		return "SI"
+	case QuantityIEC:
+		return "IEC"
+	case QuantityCPU:
+		return "CPU"
+	default:
+		return "unknown"
+	}
+}
+
+// NormalizeQuantity returns a plan modifier that parses quantities such as
+// "10GB", "10240MiB" or "500m" and plans them with the largest unit of the
+// given system that represents the value exactly. The state value is kept if
+// both quantities are numerically equal.
+func NormalizeQuantity(system QuantitySystem) planmodifier.String {
+	description := fmt.Sprintf("Normalize the quantity using %s units", system)
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			q, err := parseQuantity(req.ConfigValue.ValueString(), system)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid quantity", err.Error())
+				resp.Value = req.ConfigValue
+				return
+			}
+
+			if !req.StateValue.IsNull() && !req.StateValue.IsUnknown() {
+				if s, err := parseQuantity(req.StateValue.ValueString(), system); err == nil && s.Cmp(q) == 0 {
+					resp.Value = req.StateValue
+					return
+				}
+			}
+
+			v, err := formatQuantity(q, system)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid quantity", err.Error())
+				resp.Value = req.ConfigValue
+				return
+			}
+
+			resp.Value = types.StringValue(v)
+		},
+		description,
+		description,
+	)
+}
+
+// parseQuantity returns the quantity in the base unit of the system (bytes or
+// millicores).
+func parseQuantity(s string, system QuantitySystem) (*big.Rat, error) {
+	if system == QuantityCPU {
+		return quantity.ParseDecimal(s, quantity.CPU)
+	}
+
+	return quantity.ParseDecimal(s, quantity.Bytes)
+}
+
+// formatQuantity formats the quantity with the largest unit of the system
+// that represents it as a whole number.
+func formatQuantity(q *big.Rat, system QuantitySystem) (string, error) {
+	units := quantitySIUnits
+	switch system {
+	case QuantityIEC:
+		units = quantityIECUnits
+	case QuantityCPU:
+		units = quantityCPUUnits
+	case QuantitySI:
+	}
+
+	// Zero is a whole number in every unit, use the base unit.
+	if q.Sign() == 0 {
+		if system == QuantityCPU {
+			return "0", nil
+		}
+		return "0B", nil
+	}
+
+	for _, u := range units {
+		v := new(big.Rat).Quo(q, new(big.Rat).SetInt64(u.multiplier))
+		if v.IsInt() {
+			return v.Num().String() + u.suffix, nil
+		}
+	}
+
+	return "", fmt.Errorf("the quantity %s cannot be represented with %s units", q.FloatString(3), system)
+}
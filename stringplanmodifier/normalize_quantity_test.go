@@ -0,0 +1,173 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestNormalizeQuantityPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		system      stringplanmodifier.QuantitySystem
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			system:      stringplanmodifier.QuantityIEC,
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			system:      stringplanmodifier.QuantityIEC,
+			exceptedVal: types.StringNull(),
+		},
+		"IEC canonical unit": {
+			val:         types.StringValue("10240MiB"),
+			system:      stringplanmodifier.QuantityIEC,
+			exceptedVal: types.StringValue("10GiB"),
+		},
+		"IEC decimal amount": {
+			val:         types.StringValue("1.5 Gi"),
+			system:      stringplanmodifier.QuantityIEC,
+			exceptedVal: types.StringValue("1536MiB"),
+		},
+		"IEC from SI unit": {
+			val:         types.StringValue("10GB"),
+			system:      stringplanmodifier.QuantityIEC,
+			exceptedVal: types.StringValue("9765625KiB"),
+		},
+		"SI canonical unit": {
+			val:         types.StringValue("10000MB"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("10GB"),
+		},
+		"SI zero": {
+			val:         types.StringValue("0GB"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("0B"),
+		},
+		"leading zero": {
+			val:         types.StringValue("010GB"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("10GB"),
+		},
+		"keep equivalent state": {
+			val:         types.StringValue("10GiB"),
+			state:       types.StringValue("10240MiB"),
+			system:      stringplanmodifier.QuantityIEC,
+			exceptedVal: types.StringValue("10240MiB"),
+		},
+		"different state": {
+			val:         types.StringValue("10GiB"),
+			state:       types.StringValue("20GiB"),
+			system:      stringplanmodifier.QuantityIEC,
+			exceptedVal: types.StringValue("10GiB"),
+		},
+		"CPU millicores": {
+			val:         types.StringValue("0.5"),
+			system:      stringplanmodifier.QuantityCPU,
+			exceptedVal: types.StringValue("500m"),
+		},
+		"CPU cores": {
+			val:         types.StringValue("2000m"),
+			system:      stringplanmodifier.QuantityCPU,
+			exceptedVal: types.StringValue("2"),
+		},
+		"CPU keep equivalent state": {
+			val:         types.StringValue("0.5"),
+			state:       types.StringValue("500m"),
+			system:      stringplanmodifier.QuantityCPU,
+			exceptedVal: types.StringValue("500m"),
+		},
+		"CPU sub-millicore": {
+			val:         types.StringValue("0.0005"),
+			system:      stringplanmodifier.QuantityCPU,
+			exceptedVal: types.StringValue("0.0005"),
+			expectError: true,
+		},
+		"fractional bytes": {
+			val:         types.StringValue("0.5B"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("0.5B"),
+			expectError: true,
+		},
+		"unknown unit": {
+			val:         types.StringValue("10XB"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("10XB"),
+			expectError: true,
+		},
+		"hexadecimal": {
+			val:         types.StringValue("0x10GB"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("0x10GB"),
+			expectError: true,
+		},
+		"underscores": {
+			val:         types.StringValue("10_000MB"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("10_000MB"),
+			expectError: true,
+		},
+		"sign": {
+			val:         types.StringValue("+10GB"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("+10GB"),
+			expectError: true,
+		},
+		"invalid": {
+			val:         types.StringValue("ten gigabytes"),
+			system:      stringplanmodifier.QuantitySI,
+			exceptedVal: types.StringValue("ten gigabytes"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.NormalizeQuantity(test.system).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
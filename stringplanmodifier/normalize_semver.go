@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SemverOption configures the NormalizeSemver plan modifier.
+type SemverOption func(*semverOptions)
+
+type semverOptions struct {
+	ignoreBuildMetadata bool
+}
+
+// SemverIgnoreBuildMetadata ignores the build metadata ("+build") when
+// comparing the configured and state versions.
+func SemverIgnoreBuildMetadata() SemverOption {
+	return func(o *semverOptions) {
+		o.ignoreBuildMetadata = true
+	}
+}
+
+// semverRegexp leniently matches a version: an optional "v" prefix, one to
+// three numeric components, an optional pre-release and build metadata.
+var semverRegexp = regexp.MustCompile(`^[vV]?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// NormalizeSemver returns a plan modifier that parses the version leniently
+// (e.g. "v1.2" or "1.2.0+build") and plans its canonical form
+// "MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]". The state value is kept if both
+// versions are equal.
+func NormalizeSemver(opts ...SemverOption) planmodifier.String {
+	o := &semverOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	key := func(s string) (string, error) {
+		v, err := normalizeSemver(s)
+		if err != nil || !o.ignoreBuildMetadata {
+			return v, err
+		}
+		v, _, _ = strings.Cut(v, "+")
+		return v, nil
+	}
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			v, err := normalizeSemver(req.ConfigValue.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid version", err.Error())
+				resp.Value = req.ConfigValue
+				return
+			}
+
+			if k, err := key(v); err == nil && stateEqual(key, req.StateValue, k) {
+				resp.Value = req.StateValue
+				return
+			}
+
+			resp.Value = types.StringValue(v)
+		},
+		"Normalize the semantic version",
+		"Normalize the semantic version",
+	)
+}
+
+func normalizeSemver(s string) (string, error) {
+	m := semverRegexp.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return "", fmt.Errorf("the value %q is not a valid semantic version", s)
+	}
+
+	parts := make([]string, 3)
+	for i := range parts {
+		if m[i+1] == "" {
+			parts[i] = "0"
+			continue
+		}
+
+		n, err := strconv.ParseUint(m[i+1], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("the value %q is not a valid semantic version: %w", s, err)
+		}
+		parts[i] = strconv.FormatUint(n, 10)
+	}
+
+	v := strings.Join(parts, ".")
+	if m[4] != "" {
+		v += "-" + m[4]
+	}
+	if m[5] != "" {
+		v += "+" + m[5]
+	}
+
+	return v, nil
+}
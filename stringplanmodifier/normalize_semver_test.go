@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestNormalizeSemverPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		opts        []stringplanmodifier.SemverOption
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"prefix and missing patch": {
+			val:         types.StringValue("v1.2"),
+			exceptedVal: types.StringValue("1.2.0"),
+		},
+		"major only": {
+			val:         types.StringValue("3"),
+			exceptedVal: types.StringValue("3.0.0"),
+		},
+		"leading zeros": {
+			val:         types.StringValue("01.02.003"),
+			exceptedVal: types.StringValue("1.2.3"),
+		},
+		"pre-release and build": {
+			val:         types.StringValue("V1.2-rc.1+build.5"),
+			exceptedVal: types.StringValue("1.2.0-rc.1+build.5"),
+		},
+		"keep equivalent state": {
+			val:         types.StringValue("v1.2"),
+			state:       types.StringValue("1.2.0"),
+			exceptedVal: types.StringValue("1.2.0"),
+		},
+		"different build metadata": {
+			val:         types.StringValue("1.2.0+build"),
+			state:       types.StringValue("1.2.0"),
+			exceptedVal: types.StringValue("1.2.0+build"),
+		},
+		"ignore build metadata": {
+			val:         types.StringValue("1.2.0+build"),
+			state:       types.StringValue("1.2.0"),
+			opts:        []stringplanmodifier.SemverOption{stringplanmodifier.SemverIgnoreBuildMetadata()},
+			exceptedVal: types.StringValue("1.2.0"),
+		},
+		"different version": {
+			val:         types.StringValue("v1.3"),
+			state:       types.StringValue("1.2.0"),
+			exceptedVal: types.StringValue("1.3.0"),
+		},
+		"invalid": {
+			val:         types.StringValue("latest"),
+			exceptedVal: types.StringValue("latest"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.NormalizeSemver(test.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
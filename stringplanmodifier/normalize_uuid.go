@@ -0,0 +1,47 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-?[0-9a-f]{4}-?[0-9a-f]{4}-?[0-9a-f]{4}-?[0-9a-f]{12}$`)
+
+// NormalizeUUID returns a plan modifier that plans the canonical form of the
+// UUID (lowercase and hyphenated). Braces and missing hyphens are accepted.
+// The state value is kept if both UUIDs are equal.
+func NormalizeUUID() planmodifier.String {
+	return setNormalizeStringFunc(
+		normalizeUUID,
+		"Normalize the UUID to its lowercase hyphenated form",
+		"Normalize the UUID to its lowercase hyphenated form",
+	)
+}
+
+func normalizeUUID(s string) (string, error) {
+	u := strings.ToLower(strings.TrimSpace(s))
+	if strings.HasPrefix(u, "{") && strings.HasSuffix(u, "}") {
+		u = u[1 : len(u)-1]
+	}
+
+	if !uuidRegexp.MatchString(u) {
+		return "", fmt.Errorf("the value %q is not a valid UUID", s)
+	}
+
+	u = strings.ReplaceAll(u, "-", "")
+
+	return fmt.Sprintf("%s-%s-%s-%s-%s", u[0:8], u[8:12], u[12:16], u[16:20], u[20:32]), nil
+}
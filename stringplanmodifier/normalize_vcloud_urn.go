@@ -0,0 +1,58 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+const vcloudURNPrefix = "urn:vcloud:"
+
+// NormalizeVCloudURN returns a plan modifier that plans the canonical vCloud
+// URN "urn:vcloud:<objectType>:<uuid>" in lowercase. Both the URN and the
+// bare UUID are accepted. The state value is kept if both UUIDs are equal.
+func NormalizeVCloudURN(objectType string) planmodifier.String {
+	objectType = strings.ToLower(objectType)
+	description := fmt.Sprintf("Normalize the value to the URN %s%s:<uuid>", vcloudURNPrefix, objectType)
+
+	return setNormalizeStringFunc(
+		func(s string) (string, error) {
+			return normalizeVCloudURN(s, objectType)
+		},
+		description,
+		description,
+	)
+}
+
+func normalizeVCloudURN(s, objectType string) (string, error) {
+	v := strings.TrimSpace(s)
+
+	if len(v) > len(vcloudURNPrefix) && strings.EqualFold(v[:len(vcloudURNPrefix)], vcloudURNPrefix) {
+		t, id, ok := strings.Cut(v[len(vcloudURNPrefix):], ":")
+		if !ok {
+			return "", fmt.Errorf("the value %q is not a valid vCloud URN", s)
+		}
+		if !strings.EqualFold(t, objectType) {
+			return "", fmt.Errorf("the value %q is not a vCloud URN of type %q", s, objectType)
+		}
+		v = id
+	}
+
+	id, err := normalizeUUID(v)
+	if err != nil {
+		return "", fmt.Errorf("the value %q is neither a vCloud URN of type %q nor a UUID", s, objectType)
+	}
+
+	return vcloudURNPrefix + objectType + ":" + id, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestNormalizeVCloudURNPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	const urn = "urn:vcloud:vdc:3fa85f64-5717-4562-b3fc-2c963f66afa6"
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"canonical URN": {
+			val:         types.StringValue(urn),
+			exceptedVal: types.StringValue(urn),
+		},
+		"uppercase URN": {
+			val:         types.StringValue("URN:VCLOUD:VDC:3FA85F64-5717-4562-B3FC-2C963F66AFA6"),
+			exceptedVal: types.StringValue(urn),
+		},
+		"bare UUID": {
+			val:         types.StringValue("3FA85F64-5717-4562-B3FC-2C963F66AFA6"),
+			exceptedVal: types.StringValue(urn),
+		},
+		"keep equivalent state": {
+			val:         types.StringValue("3fa85f64-5717-4562-b3fc-2c963f66afa6"),
+			state:       types.StringValue(urn),
+			exceptedVal: types.StringValue(urn),
+		},
+		"different state": {
+			val:         types.StringValue("3fa85f64-5717-4562-b3fc-2c963f66afa6"),
+			state:       types.StringValue("urn:vcloud:vdc:00000000-0000-0000-0000-000000000000"),
+			exceptedVal: types.StringValue(urn),
+		},
+		"wrong object type": {
+			val:         types.StringValue("urn:vcloud:org:3fa85f64-5717-4562-b3fc-2c963f66afa6"),
+			exceptedVal: types.StringValue("urn:vcloud:org:3fa85f64-5717-4562-b3fc-2c963f66afa6"),
+			expectError: true,
+		},
+		"invalid UUID": {
+			val:         types.StringValue("urn:vcloud:vdc:not-a-uuid"),
+			exceptedVal: types.StringValue("urn:vcloud:vdc:not-a-uuid"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.NormalizeVCloudURN("vdc").PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// PEMOption configures the PEMSemanticEquality plan modifier.
+type PEMOption func(*pemOptions)
+
+type pemOptions struct {
+	ignoreChainOrder bool
+}
+
+// PEMIgnoreChainOrder considers two PEM values equal if they contain the same
+// blocks in a different order.
+func PEMIgnoreChainOrder() PEMOption {
+	return func(o *pemOptions) {
+		o.ignoreChainOrder = true
+	}
+}
+
+// PEMSemanticEquality returns a plan modifier that keeps the state value if
+// the PEM blocks of the configured and state values have the same types and
+// DER bytes. Line wrapping and PEM headers are ignored, data around the blocks
+// is an error.
+func PEMSemanticEquality(opts ...PEMOption) planmodifier.String {
+	o := &pemOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return setSemanticEqualityStringFunc(
+		func(s string) (string, error) {
+			return normalizePEM(s, o)
+		},
+		"Compare PEM values by their DER content",
+		"Compare PEM values by their DER content",
+	)
+}
+
+// normalizePEM returns the type and the hexadecimal DER bytes of each PEM
+// block, one per line.
+func normalizePEM(s string, o *pemOptions) (string, error) {
+	var (
+		blocks []string
+		rest   = []byte(s)
+	)
+
+	for {
+		// pem.Decode skips the data before a block, it is rejected like the
+		// data after the last one.
+		i := bytes.Index(rest, []byte("-----BEGIN"))
+		if i < 0 {
+			break
+		}
+		if len(bytes.TrimSpace(rest[:i])) != 0 {
+			return "", errors.New("the value contains data before a PEM block")
+		}
+
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block.Type+":"+hex.EncodeToString(block.Bytes))
+	}
+
+	if len(blocks) == 0 {
+		return "", errors.New("the value does not contain any valid PEM block")
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return "", errors.New("the value contains malformed PEM data")
+	}
+
+	if o.ignoreChainOrder {
+		sort.Strings(blocks)
+	}
+
+	return strings.Join(blocks, "\n"), nil
+}
@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestPEMSemanticEqualityPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	leaf := []byte(strings.Repeat("leaf certificate DER content ", 5))
+	intermediate := []byte(strings.Repeat("intermediate certificate DER content ", 5))
+
+	encode := func(ders ...[]byte) string {
+		var b strings.Builder
+		for _, der := range ders {
+			b.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+		}
+		return b.String()
+	}
+
+	// Same DER content wrapped on a single line with a PEM header.
+	rewrapped := "-----BEGIN CERTIFICATE-----\r\nComment: re-encoded\r\n\r\n" + base64.StdEncoding.EncodeToString(leaf) + "\r\n-----END CERTIFICATE-----\r\n"
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		opts        []stringplanmodifier.PEMOption
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"null state": {
+			val:         types.StringValue(encode(leaf)),
+			state:       types.StringNull(),
+			exceptedVal: types.StringValue(encode(leaf)),
+		},
+		"re-encoded": {
+			val:         types.StringValue(rewrapped),
+			state:       types.StringValue(encode(leaf)),
+			exceptedVal: types.StringValue(encode(leaf)),
+		},
+		"different content": {
+			val:         types.StringValue(encode(intermediate)),
+			state:       types.StringValue(encode(leaf)),
+			exceptedVal: types.StringValue(encode(intermediate)),
+		},
+		"different type": {
+			val:         types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: leaf}))),
+			state:       types.StringValue(encode(leaf)),
+			exceptedVal: types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: leaf}))),
+		},
+		"chain order": {
+			val:         types.StringValue(encode(intermediate, leaf)),
+			state:       types.StringValue(encode(leaf, intermediate)),
+			exceptedVal: types.StringValue(encode(intermediate, leaf)),
+		},
+		"ignore chain order": {
+			val:         types.StringValue(encode(intermediate, leaf)),
+			state:       types.StringValue(encode(leaf, intermediate)),
+			opts:        []stringplanmodifier.PEMOption{stringplanmodifier.PEMIgnoreChainOrder()},
+			exceptedVal: types.StringValue(encode(leaf, intermediate)),
+		},
+		"not PEM": {
+			val:         types.StringValue("not a certificate"),
+			state:       types.StringValue(encode(leaf)),
+			exceptedVal: types.StringValue("not a certificate"),
+			expectError: true,
+		},
+		"leading garbage": {
+			val:         types.StringValue("garbage\n" + encode(leaf)),
+			state:       types.StringValue(encode(leaf)),
+			exceptedVal: types.StringValue("garbage\n" + encode(leaf)),
+			expectError: true,
+		},
+		"garbage between blocks": {
+			val:         types.StringValue(encode(leaf) + "garbage\n" + encode(intermediate)),
+			state:       types.StringValue(encode(leaf, intermediate)),
+			exceptedVal: types.StringValue(encode(leaf) + "garbage\n" + encode(intermediate)),
+			expectError: true,
+		},
+		"leading white spaces": {
+			val:         types.StringValue("\n  \n" + encode(leaf)),
+			state:       types.StringValue(encode(leaf)),
+			exceptedVal: types.StringValue(encode(leaf)),
+		},
+		"trailing garbage": {
+			val:         types.StringValue(encode(leaf) + "garbage"),
+			state:       types.StringValue(encode(leaf)),
+			exceptedVal: types.StringValue(encode(leaf) + "garbage"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.PEMSemanticEquality(test.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SlugifyOption configures the Slugify plan modifier.
+type SlugifyOption func(*slugifyOptions)
+
+type slugifyOptions struct {
+	separator    string
+	allowedChars string
+	maxLength    int
+	warnOnChange bool
+}
+
+// SlugifySeparator sets the separator used to replace disallowed characters.
+// The default separator is "-".
+func SlugifySeparator(separator string) SlugifyOption {
+	return func(o *slugifyOptions) {
+		o.separator = separator
+	}
+}
+
+// SlugifyAllowedChars sets the characters allowed in addition to lowercase
+// ASCII letters and digits (e.g. "._").
+func SlugifyAllowedChars(chars string) SlugifyOption {
+	return func(o *slugifyOptions) {
+		o.allowedChars = chars
+	}
+}
+
+// SlugifyMaxLength truncates the slug to the given number of characters.
+// A value of 0 (default) disables the truncation.
+func SlugifyMaxLength(maxLength int) SlugifyOption {
+	return func(o *slugifyOptions) {
+		o.maxLength = maxLength
+	}
+}
+
+// SlugifyWarnOnChange emits a warning diagnostic when the planned value
+// differs from the configured one.
+func SlugifyWarnOnChange() SlugifyOption {
+	return func(o *slugifyOptions) {
+		o.warnOnChange = true
+	}
+}
+
+// slugifyReplacer handles the letters that have no unicode decomposition.
+var slugifyReplacer = strings.NewReplacer(
+	"ß", "ss",
+	"æ", "ae", "Æ", "ae",
+	"œ", "oe", "Œ", "oe",
+	"ø", "o", "Ø", "o",
+	"đ", "d", "Đ", "d",
+	"ł", "l", "Ł", "l",
+	"þ", "th", "Þ", "th",
+)
+
+// Slugify returns a plan modifier that converts the configured value into a
+// slug:
+//
+//   - Accents are transliterated to their ASCII equivalent.
+//   - The value is lowercased.
+//   - Disallowed characters are replaced by the separator.
+//   - Repeated separators are collapsed and leading/trailing ones removed.
+//
+// An error is returned if the slug is empty (e.g. the value is written in a
+// non-latin script).
+func Slugify(opts ...SlugifyOption) planmodifier.String {
+	o := &slugifyOptions{
+		separator: "-",
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	description := fmt.Sprintf("Slugify the value using the separator %q", o.separator)
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			v := slugify(req.ConfigValue.ValueString(), o)
+			if v == "" {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Unable to slugify value",
+					fmt.Sprintf("The value %q has no character allowed in a slug", req.ConfigValue.ValueString()),
+				)
+				resp.Value = req.ConfigValue
+				return
+			}
+			if o.warnOnChange && v != req.ConfigValue.ValueString() {
+				resp.Diagnostics.AddAttributeWarning(
+					req.Path,
+					"Value slugified",
+					fmt.Sprintf("The value %q has been changed to %q", req.ConfigValue.ValueString(), v),
+				)
+			}
+			resp.Value = types.StringValue(v)
+		},
+		description,
+		description,
+	)
+}
+
+func slugify(s string, o *slugifyOptions) string {
+	s = slugifyReplacer.Replace(s)
+
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	if r, _, err := transform.String(t, s); err == nil {
+		s = r
+	}
+
+	s = strings.ToLower(s)
+
+	var b strings.Builder
+	pendingSeparator := false
+	for _, r := range s {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || strings.ContainsRune(o.allowedChars, r) {
+			if pendingSeparator && b.Len() > 0 {
+				b.WriteString(o.separator)
+			}
+			pendingSeparator = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingSeparator = true
+	}
+
+	slug := b.String()
+
+	// The separator may be in the allowed characters, so it is copied as is.
+	if o.separator != "" {
+		for strings.Contains(slug, o.separator+o.separator) {
+			slug = strings.ReplaceAll(slug, o.separator+o.separator, o.separator)
+		}
+		slug = strings.TrimPrefix(strings.TrimSuffix(slug, o.separator), o.separator)
+	}
+
+	if r := []rune(slug); o.maxLength > 0 && len(r) > o.maxLength {
+		slug = string(r[:o.maxLength])
+		for o.separator != "" && strings.HasSuffix(slug, o.separator) {
+			slug = strings.TrimSuffix(slug, o.separator)
+		}
+	}
+
+	return slug
+}
@@ -0,0 +1,127 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestSlugifyPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val           types.String
+		opts          []stringplanmodifier.SlugifyOption
+		exceptedVal   types.String
+		expectWarning bool
+		expectError   bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"already a slug": {
+			val:         types.StringValue("my-vdc-01"),
+			exceptedVal: types.StringValue("my-vdc-01"),
+		},
+		"display name": {
+			val:         types.StringValue("  Équipe Réseau -- Édge Gateway!! "),
+			exceptedVal: types.StringValue("equipe-reseau-edge-gateway"),
+		},
+		"special letters": {
+			val:         types.StringValue("Straße Øresund"),
+			exceptedVal: types.StringValue("strasse-oresund"),
+		},
+		"custom separator": {
+			val:         types.StringValue("My VDC"),
+			opts:        []stringplanmodifier.SlugifyOption{stringplanmodifier.SlugifySeparator("_")},
+			exceptedVal: types.StringValue("my_vdc"),
+		},
+		"allowed chars": {
+			val:         types.StringValue("App v1.2 (prod)"),
+			opts:        []stringplanmodifier.SlugifyOption{stringplanmodifier.SlugifyAllowedChars(".")},
+			exceptedVal: types.StringValue("app-v1.2-prod"),
+		},
+		"separator in allowed chars": {
+			val:         types.StringValue("-a -- b-"),
+			opts:        []stringplanmodifier.SlugifyOption{stringplanmodifier.SlugifyAllowedChars("-")},
+			exceptedVal: types.StringValue("a-b"),
+		},
+		"max length": {
+			val:         types.StringValue("my long name"),
+			opts:        []stringplanmodifier.SlugifyOption{stringplanmodifier.SlugifyMaxLength(8)},
+			exceptedVal: types.StringValue("my-long"),
+		},
+		"max length on rune boundary": {
+			val:         types.StringValue("a→b→c"),
+			opts:        []stringplanmodifier.SlugifyOption{stringplanmodifier.SlugifyAllowedChars("→"), stringplanmodifier.SlugifyMaxLength(2)},
+			exceptedVal: types.StringValue("a→"),
+		},
+		"empty slug": {
+			val:         types.StringValue("Привет"),
+			exceptedVal: types.StringValue("Привет"),
+			expectError: true,
+		},
+		"warning on change": {
+			val:           types.StringValue("My VDC"),
+			opts:          []stringplanmodifier.SlugifyOption{stringplanmodifier.SlugifyWarnOnChange()},
+			exceptedVal:   types.StringValue("my-vdc"),
+			expectWarning: true,
+		},
+		"no warning when unchanged": {
+			val:         types.StringValue("my-vdc"),
+			opts:        []stringplanmodifier.SlugifyOption{stringplanmodifier.SlugifyWarnOnChange()},
+			exceptedVal: types.StringValue("my-vdc"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.Slugify(test.opts...).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != test.expectWarning {
+				t.Errorf("expected warning: %v, got: %v", test.expectWarning, resp.Diagnostics)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
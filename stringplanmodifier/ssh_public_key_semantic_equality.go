@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// SSHPublicKeySemanticEquality returns a plan modifier that keeps the state
+// value if the configured and state values are the same SSH public key. The
+// values are parsed using the authorized_keys format, one key per line, and
+// only the key types and blobs are compared, the options and comments are
+// ignored. The keys are compared in order.
+func SSHPublicKeySemanticEquality() planmodifier.String {
+	return setSemanticEqualityStringFunc(
+		normalizeSSHPublicKey,
+		"Compare SSH public keys by their type and blob",
+		"Compare SSH public keys by their type and blob",
+	)
+}
+
+// normalizeSSHPublicKey returns the "<type> <blob>" form of each
+// authorized_keys line, one per line. The empty lines and the lines starting
+// with # are ignored.
+func normalizeSSHPublicKey(s string) (string, error) {
+	var keys []string
+	for n, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, err := normalizeSSHPublicKeyLine(line)
+		if err != nil {
+			return "", fmt.Errorf("line %d: %w", n+1, err)
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return "", errors.New("the value is not a valid SSH public key in authorized_keys format")
+	}
+
+	return strings.Join(keys, "\n"), nil
+}
+
+// normalizeSSHPublicKeyLine returns the "<type> <blob>" form of an
+// authorized_keys line.
+func normalizeSSHPublicKeyLine(line string) (string, error) {
+	fields := strings.Fields(line)
+
+	// The key may be preceded by options (e.g. `from="10.0.0.1" ssh-ed25519 ...`),
+	// so look for the first field followed by a blob encoding the same key type.
+	for i := 0; i+1 < len(fields); i++ {
+		key, ok := sshPublicKeyAt(fields, i)
+		if !ok {
+			continue
+		}
+
+		// The rest of the line is the comment, it must not hold another key.
+		for j := i + 2; j+1 < len(fields); j++ {
+			if _, ok := sshPublicKeyAt(fields, j); ok {
+				return "", errors.New("the line contains more than one SSH public key")
+			}
+		}
+
+		return key, nil
+	}
+
+	return "", errors.New("the value is not a valid SSH public key in authorized_keys format")
+}
+
+// sshPublicKeyAt returns the "<type> <blob>" form of the key at fields[i] and
+// fields[i+1], if they are a key type and a blob encoding the same key type.
+func sshPublicKeyAt(fields []string, i int) (string, bool) {
+	blob, err := base64.StdEncoding.DecodeString(fields[i+1])
+	if err != nil {
+		return "", false
+	}
+
+	keyType, err := sshBlobKeyType(blob)
+	if err != nil || keyType != fields[i] {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s %s", keyType, base64.StdEncoding.EncodeToString(blob)), true
+}
+
+// sshBlobKeyType returns the key type encoded at the beginning of an SSH
+// public key blob.
+func sshBlobKeyType(blob []byte) (string, error) {
+	if len(blob) < 4 {
+		return "", errors.New("blob too short")
+	}
+
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(n) > uint64(len(blob)-4) {
+		return "", errors.New("invalid key type length")
+	}
+
+	return string(blob[4 : 4+n]), nil
+}
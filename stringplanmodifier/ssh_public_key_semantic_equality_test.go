@@ -0,0 +1,129 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestSSHPublicKeySemanticEqualityPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	const (
+		key      = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+		otherKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIB7Oq7jLkq0Vh3Mm7b9b3Y2gVZ2KX5iP4b0rYQ3Z8mYk"
+	)
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"null state": {
+			val:         types.StringValue(key + " user@host"),
+			state:       types.StringNull(),
+			exceptedVal: types.StringValue(key + " user@host"),
+		},
+		"comment removed by the API": {
+			val:         types.StringValue(key + " user@host"),
+			state:       types.StringValue(key),
+			exceptedVal: types.StringValue(key),
+		},
+		"options and whitespace": {
+			val:         types.StringValue(`from="10.0.0.1",no-pty   ` + key + "   my comment\n"),
+			state:       types.StringValue(key),
+			exceptedVal: types.StringValue(key),
+		},
+		"different key": {
+			val:         types.StringValue(otherKey + " user@host"),
+			state:       types.StringValue(key),
+			exceptedVal: types.StringValue(otherKey + " user@host"),
+		},
+		"key added": {
+			val:         types.StringValue(key + " user@host\n" + otherKey + " other@host\n"),
+			state:       types.StringValue(key + " user@host"),
+			exceptedVal: types.StringValue(key + " user@host\n" + otherKey + " other@host\n"),
+		},
+		"same keys": {
+			val:         types.StringValue("# team keys\n" + key + " user@host\n\n" + otherKey + "\n"),
+			state:       types.StringValue(key + "\n" + otherKey),
+			exceptedVal: types.StringValue(key + "\n" + otherKey),
+		},
+		"two keys on a line": {
+			val:         types.StringValue(key + " " + otherKey),
+			state:       types.StringValue(key),
+			exceptedVal: types.StringValue(key + " " + otherKey),
+			expectError: true,
+		},
+		"invalid line": {
+			val:         types.StringValue(key + "\nnot a key"),
+			state:       types.StringValue(key),
+			exceptedVal: types.StringValue(key + "\nnot a key"),
+			expectError: true,
+		},
+		"mismatched key type": {
+			val:         types.StringValue("ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"),
+			state:       types.StringValue(key),
+			exceptedVal: types.StringValue("ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"),
+			expectError: true,
+		},
+		"invalid key": {
+			val:         types.StringValue("not a key"),
+			state:       types.StringValue(key),
+			exceptedVal: types.StringValue("not a key"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.SSHPublicKeySemanticEquality().PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// TruncateWithHash returns a plan modifier that shortens values longer than
+// maxLen characters. The value is truncated and suffixed with "-" followed by
+// the first hashLen hexadecimal characters of the SHA-256 of the full value,
+// so the result stays unique and stable across plans.
+//
+// Values that are not longer than maxLen are left unchanged.
+func TruncateWithHash(maxLen, hashLen int) planmodifier.String {
+	description := fmt.Sprintf("Truncate the value to %d characters and append a hash of %d characters", maxLen, hashLen)
+
+	return setChangeStringFunc(
+		func(_ context.Context, req planmodifier.StringRequest, resp *StringChangeFuncResponse) {
+			if hashLen <= 0 || hashLen > sha256.Size*2 || hashLen+1 >= maxLen {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Invalid TruncateWithHash parameters",
+					fmt.Sprintf("The hash length must be between 1 and %d and lower than the maximum length minus one (maxLen: %d, hashLen: %d)", sha256.Size*2, maxLen, hashLen),
+				)
+				return
+			}
+
+			resp.Value = types.StringValue(truncateWithHash(req.ConfigValue.ValueString(), maxLen, hashLen))
+		},
+		description,
+		description,
+	)
+}
+
+func truncateWithHash(s string, maxLen, hashLen int) string {
+	r := []rune(s)
+	if len(r) <= maxLen {
+		return s
+	}
+
+	sum := sha256.Sum256([]byte(s))
+
+	return string(r[:maxLen-hashLen-1]) + "-" + hex.EncodeToString(sum[:])[:hashLen]
+}
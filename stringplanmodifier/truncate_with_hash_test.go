@@ -0,0 +1,107 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestTruncateWithHashPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		maxLen      int
+		hashLen     int
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			maxLen:      16,
+			hashLen:     6,
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			maxLen:      16,
+			hashLen:     6,
+			exceptedVal: types.StringNull(),
+		},
+		"short String": {
+			val:         types.StringValue("prefix-ws-comp"),
+			maxLen:      16,
+			hashLen:     6,
+			exceptedVal: types.StringValue("prefix-ws-comp"),
+		},
+		"exact length String": {
+			val:         types.StringValue("prefix-workspace"),
+			maxLen:      16,
+			hashLen:     6,
+			exceptedVal: types.StringValue("prefix-workspace"),
+		},
+		"long String": {
+			// sha256("prefix-workspace-component") = 0eca0e60...
+			val:         types.StringValue("prefix-workspace-component"),
+			maxLen:      16,
+			hashLen:     6,
+			exceptedVal: types.StringValue("prefix-wo-0eca0e"),
+		},
+		"invalid hash length": {
+			val:         types.StringValue("prefix-workspace-component"),
+			maxLen:      16,
+			hashLen:     0,
+			exceptedVal: types.StringNull(),
+			expectError: true,
+		},
+		"hash length too large": {
+			val:         types.StringValue("prefix-workspace-component"),
+			maxLen:      16,
+			hashLen:     15,
+			exceptedVal: types.StringNull(),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.TruncateWithHash(test.maxLen, test.hashLen).PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// XMLSemanticEquality returns a plan modifier that keeps the state value if
+// the configured and state values are structurally equal XML documents. The
+// attribute order, namespace prefixes, comments, the XML declaration and the
+// whitespace around text are ignored.
+func XMLSemanticEquality() planmodifier.String {
+	return setSemanticEqualityStringFunc(
+		canonicalXML,
+		"Compare XML documents structurally",
+		"Compare XML documents structurally",
+	)
+}
+
+// canonicalXML returns a canonical representation of the XML document.
+func canonicalXML(s string) (string, error) {
+	var (
+		b     strings.Builder
+		root  bool
+		depth int
+		d     = xml.NewDecoder(strings.NewReader(s))
+	)
+
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("the value is not a valid XML document: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if depth == 0 && root {
+				return "", errors.New("the value is not a valid XML document: more than one root element")
+			}
+			root = true
+			depth++
+			attrs := make([]string, 0, len(t.Attr))
+			for _, a := range t.Attr {
+				// Namespace declarations are resolved in the element and
+				// attribute names.
+				if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+					continue
+				}
+				attrs = append(attrs, fmt.Sprintf("{%s}%s=%q", a.Name.Space, a.Name.Local, a.Value))
+			}
+			sort.Strings(attrs)
+			fmt.Fprintf(&b, "<{%s}%s %s>", t.Name.Space, t.Name.Local, strings.Join(attrs, " "))
+		case xml.EndElement:
+			depth--
+			fmt.Fprintf(&b, "</{%s}%s>", t.Name.Space, t.Name.Local)
+		case xml.CharData:
+			text := strings.TrimSpace(string(t))
+			switch {
+			case text == "":
+			case depth == 0:
+				return "", errors.New("the value is not a valid XML document: text outside the root element")
+			default:
+				fmt.Fprintf(&b, "%q", text)
+			}
+		case xml.Comment, xml.ProcInst, xml.Directive:
+		}
+	}
+
+	if !root {
+		return "", errors.New("the value is not a valid XML document: no root element")
+	}
+
+	return b.String(), nil
+}
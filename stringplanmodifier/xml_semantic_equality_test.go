@@ -0,0 +1,123 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package stringplanmodifier provides a plan modifier for string values.
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/stringplanmodifier"
+)
+
+func TestXMLSemanticEqualityPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	const doc = `<GuestCustomizationSection xmlns="http://www.vmware.com/vcloud/v1.5" required="false" type="script"><Enabled>true</Enabled><CustomizationScript>echo hello</CustomizationScript></GuestCustomizationSection>`
+
+	type testCase struct {
+		val         types.String
+		state       types.String
+		exceptedVal types.String
+		expectError bool
+	}
+
+	tests := map[string]testCase{
+		"unknown String": {
+			val:         types.StringUnknown(),
+			exceptedVal: types.StringNull(),
+		},
+		"null String": {
+			val:         types.StringNull(),
+			exceptedVal: types.StringNull(),
+		},
+		"null state": {
+			val:         types.StringValue(doc),
+			state:       types.StringNull(),
+			exceptedVal: types.StringValue(doc),
+		},
+		"attribute order, prefix and whitespace": {
+			val: types.StringValue(`<?xml version="1.0" encoding="UTF-8"?>
+<!-- generated -->
+<vcloud:GuestCustomizationSection type="script" required="false" xmlns:vcloud="http://www.vmware.com/vcloud/v1.5">
+    <vcloud:Enabled>true</vcloud:Enabled>
+    <vcloud:CustomizationScript>
+        echo hello
+    </vcloud:CustomizationScript>
+</vcloud:GuestCustomizationSection>`),
+			state:       types.StringValue(doc),
+			exceptedVal: types.StringValue(doc),
+		},
+		"different attribute value": {
+			val:         types.StringValue(`<GuestCustomizationSection xmlns="http://www.vmware.com/vcloud/v1.5" required="true" type="script"><Enabled>true</Enabled><CustomizationScript>echo hello</CustomizationScript></GuestCustomizationSection>`),
+			state:       types.StringValue(doc),
+			exceptedVal: types.StringValue(`<GuestCustomizationSection xmlns="http://www.vmware.com/vcloud/v1.5" required="true" type="script"><Enabled>true</Enabled><CustomizationScript>echo hello</CustomizationScript></GuestCustomizationSection>`),
+		},
+		"different namespace": {
+			val:         types.StringValue(`<GuestCustomizationSection required="false" type="script"><Enabled>true</Enabled><CustomizationScript>echo hello</CustomizationScript></GuestCustomizationSection>`),
+			state:       types.StringValue(doc),
+			exceptedVal: types.StringValue(`<GuestCustomizationSection required="false" type="script"><Enabled>true</Enabled><CustomizationScript>echo hello</CustomizationScript></GuestCustomizationSection>`),
+		},
+		"malformed": {
+			val:         types.StringValue(`<Section><Enabled>true</Section>`),
+			state:       types.StringValue(doc),
+			exceptedVal: types.StringValue(`<Section><Enabled>true</Section>`),
+			expectError: true,
+		},
+		"two root elements": {
+			val:         types.StringValue(`<a/><b/>`),
+			state:       types.StringValue(`<a/>`),
+			exceptedVal: types.StringValue(`<a/><b/>`),
+			expectError: true,
+		},
+		"text after the root element": {
+			val:         types.StringValue(`<a/>junk`),
+			state:       types.StringValue(`<a/>`),
+			exceptedVal: types.StringValue(`<a/>junk`),
+			expectError: true,
+		},
+		"no root element": {
+			val:         types.StringValue(`just text`),
+			state:       types.StringValue(doc),
+			exceptedVal: types.StringValue(`just text`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := planmodifier.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+				StateValue:     test.state,
+			}
+
+			resp := &planmodifier.StringResponse{}
+			stringplanmodifier.XMLSemanticEquality().PlanModifyString(context.Background(), request, resp)
+
+			if diff := cmp.Diff(test.exceptedVal, resp.PlanValue); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("expected error: %v, got: %v", test.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
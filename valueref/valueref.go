@@ -0,0 +1,163 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package valueref resolves value references such as "env://CLOUDAVENUE_ORG",
+// "file:///etc/ca/org" or "literal://default". It is used by the
+// SetDefaultFromRef plan modifiers.
+//
+// The resolvers are registered by scheme. The env, file and literal schemes
+// are built in, providers can register their own schemes with Register.
+package valueref
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/internal/fsutil"
+)
+
+// ErrUnknownScheme is returned by Resolve if no resolver is registered for
+// the scheme of a reference.
+var ErrUnknownScheme = errors.New("unknown scheme")
+
+// ErrInvalidReference is returned by Resolve if a reference is not written
+// <scheme>://<value>.
+var ErrInvalidReference = errors.New("invalid reference")
+
+// Resolver resolves the references of a scheme.
+type Resolver interface {
+	// Resolve returns the value referenced by ref, the part of the reference
+	// after "<scheme>://". found is false if the reference has no value (e.g.
+	// the environment variable is not set), so the next reference is tried.
+	// An error stops the resolution.
+	Resolve(ctx context.Context, ref string) (value string, found bool, err error)
+}
+
+// ResolverFunc is a function implementing Resolver.
+type ResolverFunc func(ctx context.Context, ref string) (value string, found bool, err error)
+
+// Resolve calls f(ctx, ref).
+func (f ResolverFunc) Resolve(ctx context.Context, ref string) (string, bool, error) {
+	return f(ctx, ref)
+}
+
+var (
+	mu        sync.RWMutex
+	resolvers = map[string]Resolver{
+		"env":     ResolverFunc(resolveEnv),
+		"file":    ResolverFunc(resolveFile),
+		"literal": ResolverFunc(resolveLiteral),
+	}
+
+	schemeRegexp = regexp.MustCompile(`^[a-z][a-z0-9+.-]*$`)
+)
+
+// Register makes a resolver available for the scheme (e.g. "vault"). It is
+// meant to be called from the init function or the provider configuration.
+// Register panics if the scheme is invalid, if it is already registered or if
+// the resolver is nil.
+func Register(scheme string, r Resolver) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if !schemeRegexp.MatchString(scheme) {
+		panic(fmt.Sprintf("valueref: invalid scheme %q", scheme))
+	}
+	if r == nil {
+		panic(fmt.Sprintf("valueref: nil resolver for scheme %q", scheme))
+	}
+	if _, ok := resolvers[scheme]; ok {
+		panic(fmt.Sprintf("valueref: scheme %q already registered", scheme))
+	}
+
+	resolvers[scheme] = r
+}
+
+// Schemes returns the registered schemes, sorted.
+func Schemes() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	schemes := make([]string, 0, len(resolvers))
+	for s := range resolvers {
+		schemes = append(schemes, s)
+	}
+	sort.Strings(schemes)
+
+	return schemes
+}
+
+// Resolve tries the references in order and returns the value of the first
+// one that has a value, with the reference. found is false if none of them
+// has a value.
+func Resolve(ctx context.Context, refs ...string) (value, ref string, found bool, err error) {
+	for _, ref := range refs {
+		scheme, rest, ok := strings.Cut(ref, "://")
+		if !ok {
+			return "", ref, false, fmt.Errorf("%w %q: expected <scheme>://<value>", ErrInvalidReference, ref)
+		}
+
+		mu.RLock()
+		r, ok := resolvers[scheme]
+		mu.RUnlock()
+		if !ok {
+			return "", ref, false, fmt.Errorf("%w %q in the reference %q (registered schemes: %s)", ErrUnknownScheme, scheme, ref, strings.Join(Schemes(), ", "))
+		}
+
+		value, found, err := r.Resolve(ctx, rest)
+		if err != nil {
+			return "", ref, false, fmt.Errorf("reference %q: %w", ref, err)
+		}
+		if found {
+			return value, ref, true, nil
+		}
+	}
+
+	return "", "", false, nil
+}
+
+// resolveEnv resolves env://NAME to the value of the environment variable. An
+// empty environment variable has no value.
+func resolveEnv(_ context.Context, name string) (string, bool, error) {
+	v := os.Getenv(name)
+
+	return v, v != "", nil
+}
+
+// resolveFile resolves file:///path to the content of the file, without the
+// leading and trailing white spaces. A leading ~ is expanded to the home
+// directory of the user. A file that does not exist has no value.
+func resolveFile(_ context.Context, path string) (string, bool, error) {
+	p, err := fsutil.ExpandHome(path)
+	if err != nil {
+		return "", false, err
+	}
+
+	b, err := os.ReadFile(p)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return "", false, nil
+	case err != nil:
+		return "", false, err
+	}
+
+	return strings.TrimSpace(string(b)), true, nil
+}
+
+// resolveLiteral resolves literal://value to value.
+func resolveLiteral(_ context.Context, value string) (string, bool, error) {
+	return value, true, nil
+}
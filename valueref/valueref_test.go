@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 Orange
+ * SPDX-License-Identifier: Mozilla Public License 2.0
+ *
+ * This software is distributed under the MPL-2.0 license.
+ * the text of which is available at https://www.mozilla.org/en-US/MPL/2.0/
+ * or see the "LICENSE" file for more details.
+ */
+
+// Package valueref resolves value references.
+package valueref_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/orange-cloudavenue/terraform-plugin-framework-planmodifiers/valueref"
+)
+
+// The registry is global, the scheme is registered once so the tests can run
+// again with -count.
+func init() {
+	valueref.Register("testmap", valueref.ResolverFunc(func(_ context.Context, ref string) (string, bool, error) {
+		switch ref {
+		case "known":
+			return "custom", true, nil
+		case "fail":
+			return "", false, errors.New("helper failed")
+		default:
+			return "", false, nil
+		}
+	}))
+}
+
+func TestResolve(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("USERPROFILE", dir)
+	t.Setenv("TEST_VALUEREF_ORG", "env-org")
+	t.Setenv("TEST_VALUEREF_EMPTY", "")
+
+	if err := os.WriteFile(filepath.Join(dir, "org"), []byte("file-org\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		refs        []string
+		expected    string
+		expectedRef string
+		found       bool
+		expectedErr error
+		expectError bool
+	}{
+		"env": {
+			refs:        []string{"env://TEST_VALUEREF_ORG", "literal://default"},
+			expected:    "env-org",
+			expectedRef: "env://TEST_VALUEREF_ORG",
+			found:       true,
+		},
+		"empty env": {
+			refs:        []string{"env://TEST_VALUEREF_EMPTY", "literal://default"},
+			expected:    "default",
+			expectedRef: "literal://default",
+			found:       true,
+		},
+		"file": {
+			refs:        []string{"env://TEST_VALUEREF_UNSET", "file://" + filepath.Join(dir, "org")},
+			expected:    "file-org",
+			expectedRef: "file://" + filepath.Join(dir, "org"),
+			found:       true,
+		},
+		"file in home": {
+			refs:        []string{"file://~/org"},
+			expected:    "file-org",
+			expectedRef: "file://~/org",
+			found:       true,
+		},
+		"missing file": {
+			refs:        []string{"file://" + filepath.Join(dir, "missing"), "literal://"},
+			expected:    "",
+			expectedRef: "literal://",
+			found:       true,
+		},
+		"custom scheme": {
+			refs:        []string{"testmap://unknown", "testmap://known"},
+			expected:    "custom",
+			expectedRef: "testmap://known",
+			found:       true,
+		},
+		"not found": {
+			refs: []string{"env://TEST_VALUEREF_UNSET", "testmap://unknown"},
+		},
+		"resolver error": {
+			refs:        []string{"testmap://fail", "literal://default"},
+			expectedRef: "testmap://fail",
+			expectError: true,
+		},
+		"unknown scheme": {
+			refs:        []string{"vault://secret/org"},
+			expectedRef: "vault://secret/org",
+			expectedErr: valueref.ErrUnknownScheme,
+		},
+		"invalid reference": {
+			refs:        []string{"CLOUDAVENUE_ORG"},
+			expectedRef: "CLOUDAVENUE_ORG",
+			expectedErr: valueref.ErrInvalidReference,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			value, ref, found, err := valueref.Resolve(context.Background(), testCase.refs...)
+
+			switch {
+			case testCase.expectedErr != nil && !errors.Is(err, testCase.expectedErr):
+				t.Errorf("expected error %v, got: %v", testCase.expectedErr, err)
+			case testCase.expectedErr == nil && (err != nil) != testCase.expectError:
+				t.Errorf("expected error: %v, got: %v", testCase.expectError, err)
+			}
+
+			if value != testCase.expected || ref != testCase.expectedRef || found != testCase.found {
+				t.Errorf("expected (%q, %q, %v), got: (%q, %q, %v)", testCase.expected, testCase.expectedRef, testCase.found, value, ref, found)
+			}
+		})
+	}
+}
+
+func TestRegisterPanics(t *testing.T) {
+	resolver := valueref.ResolverFunc(func(_ context.Context, _ string) (string, bool, error) {
+		return "", false, nil
+	})
+
+	testCases := map[string]struct {
+		scheme   string
+		resolver valueref.Resolver
+	}{
+		"builtin scheme": {
+			scheme:   "env",
+			resolver: resolver,
+		},
+		"invalid scheme": {
+			scheme:   "My Scheme",
+			resolver: resolver,
+		},
+		"nil resolver": {
+			scheme: "testnil",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+
+			valueref.Register(testCase.scheme, testCase.resolver)
+		})
+	}
+}